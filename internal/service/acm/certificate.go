@@ -331,6 +331,22 @@ func resourceCertificate() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				// Only public certificates can be requested as exportable. Private certificates are always exportable.
+				if !diff.HasChange("options.0.export") {
+					return nil
+				}
+
+				if diff.Get("options.0.export").(string) != string(types.CertificateExportEnabled) {
+					return nil
+				}
+
+				if diff.Get("certificate_authority_arn").(string) != "" || diff.Get("certificate_body").(string) != "" {
+					return errors.New("`options.export` can only be `ENABLED` for public certificates")
+				}
+
 				return nil
 			},
 		),
@@ -53,6 +53,10 @@ func dataSourceCertificate() *schema.Resource {
 				Computed:     true,
 				AtLeastOneOf: []string{names.AttrDomain, names.AttrTags},
 			},
+			"exported": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"key_types": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -144,8 +148,10 @@ func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	var certificates []*awstypes.CertificateDetail
+	exported := make(map[string]bool)
 	for _, certificateSummary := range certificateSummaries {
 		certificateARN := aws.ToString(certificateSummary.CertificateArn)
+		exported[certificateARN] = aws.ToBool(certificateSummary.Exported)
 		certificate, err := findCertificateByARN(ctx, conn, certificateARN)
 
 		if retry.NotFound(err) {
@@ -222,6 +228,7 @@ func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(aws.ToString(matchedCertificate.CertificateArn))
 	d.Set(names.AttrARN, matchedCertificate.CertificateArn)
 	d.Set(names.AttrDomain, matchedCertificate.DomainName)
+	d.Set("exported", exported[aws.ToString(matchedCertificate.CertificateArn)])
 	d.Set(names.AttrStatus, matchedCertificate.Status)
 
 	return diags
@@ -32,6 +32,7 @@ func TestAccACMCertificateDataSource_byDomain(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrDomain, domain),
+					resource.TestCheckResourceAttr(dataSourceName, "exported", acctest.CtFalse),
 				),
 			},
 		},
@@ -1508,6 +1508,24 @@ func TestAccACMCertificate_disableReenableCTLogging(t *testing.T) {
 }
 
 // lintignore:AT002
+func TestAccACMCertificate_Imported_exportEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	commonName := acctest.RandomDomain().String()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateConfig_privateKeyExportEnabled(t, commonName),
+				ExpectError: regexache.MustCompile("`options.export` can only be `ENABLED` for public certificates"),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificate_Imported_domainName(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_acm_certificate.test"
@@ -1991,6 +2009,22 @@ resource "aws_acm_certificate" "test" {
 `, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
 }
 
+func testAccCertificateConfig_privateKeyExportEnabled(t *testing.T, commonName string) string {
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, commonName)
+
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[1]s"
+  private_key      = "%[2]s"
+
+  options {
+    export = "ENABLED"
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
+}
+
 func testAccCertificateConfig_privateKey(certificate, privateKey, chain string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
@@ -39,11 +39,13 @@ func TestAccAPIGateway_serial(t *testing.T) {
 			acctest.CtDisappears:                     testAccMethodSettings_disappears,
 			"CacheDataEncrypted":                     testAccMethodSettings_Settings_cacheDataEncrypted,
 			"CacheTTLInSeconds":                      testAccMethodSettings_Settings_cacheTTLInSeconds,
+			"CacheTTLInSecondsCachingDisabled":       testAccMethodSettings_Settings_cacheTTLInSecondsCachingDisabled,
 			"CachingEnabled":                         testAccMethodSettings_Settings_cachingEnabled,
 			"DataTraceEnabled":                       testAccMethodSettings_Settings_dataTraceEnabled,
 			"LoggingLevel":                           testAccMethodSettings_Settings_loggingLevel,
 			"MetricsEnabled":                         testAccMethodSettings_Settings_metricsEnabled,
 			"Multiple":                               testAccMethodSettings_Settings_multiple,
+			"MultipleMethodPaths":                    testAccMethodSettings_multipleMethodPaths,
 			"RequireAuthorizationForCacheControl":    testAccMethodSettings_Settings_requireAuthorizationForCacheControl,
 			"ThrottlingBurstLimit":                   testAccMethodSettings_Settings_throttlingBurstLimit,
 			"ThrottlingBurstLimitDisabledByDefault":  testAccMethodSettings_Settings_throttlingBurstLimitDisabledByDefault,
@@ -7,14 +7,17 @@ package apigateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,6 +41,8 @@ func resourceMethodSettings() *schema.Resource {
 			StateContext: resourceMethodSettingsImport,
 		},
 
+		CustomizeDiff: resourceMethodSettingsCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"method_path": {
 				Type:     schema.TypeString,
@@ -255,7 +260,7 @@ func resourceMethodSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 		StageName:       aws.String(stageName),
 	}
 
-	_, err := conn.UpdateStage(ctx, &input)
+	_, err := updateStageMethodSettings(ctx, conn, &input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating API Gateway Stage (%s): %s", id, err)
@@ -283,7 +288,7 @@ func resourceMethodSettingsDelete(ctx context.Context, d *schema.ResourceData, m
 		StageName: aws.String(d.Get("stage_name").(string)),
 	}
 
-	_, err := conn.UpdateStage(ctx, &input)
+	_, err := updateStageMethodSettings(ctx, conn, &input)
 
 	if errs.IsA[*types.NotFoundException](err) {
 		return diags
@@ -301,6 +306,45 @@ func resourceMethodSettingsDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceMethodSettingsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// cache_ttl_in_seconds is Computed, so check whether it has been configured.
+	settings := d.GetRawConfig().GetAttr("settings")
+	if !settings.IsKnown() || settings.IsNull() || settings.LengthInt() == 0 {
+		return nil
+	}
+
+	setting := settings.Index(cty.NumberIntVal(0))
+	if !setting.IsKnown() || setting.IsNull() {
+		return nil
+	}
+
+	// A TTL of 0 is commonly set while caching is disabled.
+	if v := setting.GetAttr("cache_ttl_in_seconds"); !v.IsKnown() || v.IsNull() || v.Equals(cty.NumberIntVal(0)).True() {
+		return nil
+	}
+
+	if v := setting.GetAttr("caching_enabled"); !v.IsKnown() || (!v.IsNull() && v.True()) {
+		return nil
+	}
+
+	return errors.New(`"settings.0.cache_ttl_in_seconds" can only be set to a non-zero value when "settings.0.caching_enabled" is true`)
+}
+
+// updateStageMethodSettings serializes method settings updates to the same stage so that
+// resources managing different method paths don't conflict with each other.
+func updateStageMethodSettings(ctx context.Context, conn *apigateway.Client, input *apigateway.UpdateStageInput) (*apigateway.UpdateStageOutput, error) {
+	mutexKey := fmt.Sprintf("api-gateway-stage-%s-%s", aws.ToString(input.RestApiId), aws.ToString(input.StageName))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	const (
+		timeout = 2 * time.Minute
+	)
+	return tfresource.RetryWhenIsA[*apigateway.UpdateStageOutput, *types.ConflictException](ctx, timeout, func(ctx context.Context) (*apigateway.UpdateStageOutput, error) {
+		return conn.UpdateStage(ctx, input)
+	})
+}
+
 func resourceMethodSettingsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	idParts := strings.SplitN(d.Id(), "/", 3)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -103,7 +104,7 @@ func testAccMethodSettings_Settings_cacheTTLInSeconds(t *testing.T) {
 		CheckDestroy:             testAccCheckMethodSettingsDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMethodSettingsConfig_cacheTTLInSeconds(rName, 0),
+				Config: testAccMethodSettingsConfig_cacheTTLInSeconds(rName, false, 0),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMethodSettingsExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
@@ -111,7 +112,7 @@ func testAccMethodSettings_Settings_cacheTTLInSeconds(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccMethodSettingsConfig_cacheTTLInSeconds(rName, 1),
+				Config: testAccMethodSettingsConfig_cacheTTLInSeconds(rName, true, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMethodSettingsExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
@@ -119,7 +120,7 @@ func testAccMethodSettings_Settings_cacheTTLInSeconds(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccMethodSettingsConfig_cacheTTLInSeconds(rName, 2),
+				Config: testAccMethodSettingsConfig_cacheTTLInSeconds(rName, true, 2),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckMethodSettingsExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
@@ -136,6 +137,29 @@ func testAccMethodSettings_Settings_cacheTTLInSeconds(t *testing.T) {
 	})
 }
 
+func testAccMethodSettings_Settings_cacheTTLInSecondsCachingDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMethodSettingsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMethodSettingsConfig_cacheTTLInSeconds(rName, false, 60),
+				ExpectError: regexache.MustCompile(`"settings.0.cache_ttl_in_seconds" can only be set to a non-zero value when\s+"settings.0.caching_enabled" is true`),
+			},
+			{
+				Config:             testAccMethodSettingsConfig_cacheTTLInSeconds(rName, false, 0),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccMethodSettings_Settings_cachingEnabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -546,6 +570,36 @@ func testAccMethodSettings_Settings_unauthorizedCacheControlHeaderStrategy(t *te
 	})
 }
 
+func testAccMethodSettings_multipleMethodPaths(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_method_settings.test"
+	resourceNameAll := "aws_api_gateway_method_settings.all"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMethodSettingsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMethodSettingsConfig_multipleMethodPaths(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMethodSettingsExists(ctx, t, resourceNameAll),
+					resource.TestCheckResourceAttr(resourceNameAll, "settings.0.metrics_enabled", acctest.CtTrue),
+					testAccCheckMethodSettingsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.throttling_burst_limit", "10"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.throttling_rate_limit", "5"),
+				),
+			},
+			{
+				Config:   testAccMethodSettingsConfig_multipleMethodPaths(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccMethodSettings_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -707,7 +761,7 @@ resource "aws_api_gateway_method_settings" "test" {
 `, cacheDataEncrypted))
 }
 
-func testAccMethodSettingsConfig_cacheTTLInSeconds(rName string, cacheTtlInSeconds int) string {
+func testAccMethodSettingsConfig_cacheTTLInSeconds(rName string, cachingEnabled bool, cacheTtlInSeconds int) string {
 	return acctest.ConfigCompose(testAccMethodSettingsConfig_base(rName), fmt.Sprintf(`
 resource "aws_api_gateway_method_settings" "test" {
   method_path = "${aws_api_gateway_resource.test.path_part}/${aws_api_gateway_method.test.http_method}"
@@ -715,10 +769,36 @@ resource "aws_api_gateway_method_settings" "test" {
   stage_name  = aws_api_gateway_stage.test.stage_name
 
   settings {
-    cache_ttl_in_seconds = %[1]d
+    caching_enabled      = %[1]t
+    cache_ttl_in_seconds = %[2]d
   }
 }
-`, cacheTtlInSeconds))
+`, cachingEnabled, cacheTtlInSeconds))
+}
+
+func testAccMethodSettingsConfig_multipleMethodPaths(rName string) string {
+	return acctest.ConfigCompose(testAccMethodSettingsConfig_base(rName), `
+resource "aws_api_gateway_method_settings" "all" {
+  method_path = "*/*"
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = aws_api_gateway_stage.test.stage_name
+
+  settings {
+    metrics_enabled = true
+  }
+}
+
+resource "aws_api_gateway_method_settings" "test" {
+  method_path = "${aws_api_gateway_resource.test.path_part}/${aws_api_gateway_method.test.http_method}"
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = aws_api_gateway_stage.test.stage_name
+
+  settings {
+    throttling_burst_limit = 10
+    throttling_rate_limit  = 5
+  }
+}
+`)
 }
 
 func testAccMethodSettingsConfig_cachingEnabled(rName string, cachingEnabled bool) string {
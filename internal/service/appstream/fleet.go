@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -58,10 +59,22 @@ func resourceFleet() *schema.Resource {
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"active_user_sessions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actual_user_sessions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"available": {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"available_user_sessions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"desired_instances": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -512,6 +525,10 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceFleetCustDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if err := validateFleetComputeCapacity(diff); err != nil {
+		return err
+	}
+
 	if diff.HasChange("domain_join_info") {
 		o, n := diff.GetChange("domain_join_info")
 
@@ -523,6 +540,40 @@ func resourceFleetCustDiff(_ context.Context, diff *schema.ResourceDiff, meta an
 	return nil
 }
 
+// validateFleetComputeCapacity checks that multi-session fleets (those with max_sessions_per_instance set)
+// specify desired_sessions and that single-session fleets specify desired_instances.
+func validateFleetComputeCapacity(diff *schema.ResourceDiff) error {
+	configRaw := diff.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	maxSessions := configRaw.GetAttr("max_sessions_per_instance")
+	if !maxSessions.IsKnown() {
+		return nil
+	}
+
+	computeCapacity := configRaw.GetAttr("compute_capacity")
+	if !computeCapacity.IsKnown() || computeCapacity.IsNull() || computeCapacity.LengthInt() == 0 {
+		return nil
+	}
+
+	capacity := computeCapacity.Index(cty.NumberIntVal(0))
+	desiredInstances, desiredSessions := capacity.GetAttr("desired_instances"), capacity.GetAttr("desired_sessions")
+
+	if maxSessions.IsNull() {
+		if !desiredSessions.IsNull() {
+			return errors.New(`"compute_capacity.0.desired_sessions" can only be set when "max_sessions_per_instance" is set, use "compute_capacity.0.desired_instances" instead`)
+		}
+	} else {
+		if !desiredInstances.IsNull() {
+			return errors.New(`"compute_capacity.0.desired_instances" cannot be set when "max_sessions_per_instance" is set, use "compute_capacity.0.desired_sessions" instead`)
+		}
+	}
+
+	return nil
+}
+
 func startFleet(ctx context.Context, conn *appstream.Client, id string) error {
 	input := appstream.StartFleetInput{
 		Name: aws.String(id),
@@ -714,10 +765,22 @@ func flattenComputeCapacity(apiObject *awstypes.ComputeCapacityStatus) map[strin
 
 	tfMap := map[string]any{}
 
+	if v := apiObject.ActiveUserSessions; v != nil {
+		tfMap["active_user_sessions"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.ActualUserSessions; v != nil {
+		tfMap["actual_user_sessions"] = aws.ToInt32(v)
+	}
+
 	if v := apiObject.Available; v != nil {
 		tfMap["available"] = aws.ToInt32(v)
 	}
 
+	if v := apiObject.AvailableUserSessions; v != nil {
+		tfMap["available_user_sessions"] = aws.ToInt32(v)
+	}
+
 	if v := apiObject.DesiredUserSessions; v != nil {
 		tfMap["desired_sessions"] = aws.ToInt32(v)
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -326,6 +327,9 @@ func TestAccAppStreamFleet_multiSession(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, instanceType),
 					resource.TestCheckResourceAttr(resourceName, "max_sessions_per_instance", "5"),
 					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_sessions", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "compute_capacity.0.active_user_sessions"),
+					resource.TestCheckResourceAttrSet(resourceName, "compute_capacity.0.actual_user_sessions"),
+					resource.TestCheckResourceAttrSet(resourceName, "compute_capacity.0.available_user_sessions"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.FleetStateRunning)),
 					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
 				),
@@ -351,6 +355,25 @@ func TestAccAppStreamFleet_multiSession(t *testing.T) {
 	})
 }
 
+func TestAccAppStreamFleet_multiSessionDesiredInstances(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	instanceType := "stream.standard.small"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx, t),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_multiSessionDesiredInstances(rName, instanceType),
+				ExpectError: regexache.MustCompile(`"compute_capacity.0.desired_instances" cannot be set when "max_sessions_per_instance" is set`),
+			},
+		},
+	})
+}
+
 func testAccCheckFleetDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).AppStreamClient(ctx)
@@ -581,3 +604,19 @@ resource "aws_appstream_fleet" "test" {
 }
 `, name, instanceType, desiredSessions, maxSessionsPerInstance))
 }
+
+func testAccFleetConfig_multiSessionDesiredInstances(name, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "AppStream-WinServer2022-06-17-2024"
+  instance_type = %[2]q
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  max_sessions_per_instance = 5
+}
+`, name, instanceType)
+}
@@ -590,6 +590,10 @@ func resourceComputeEnvironmentCustomizeDiff(ctx context.Context, diff *schema.R
 		}
 	}
 
+	if err := validateComputeEnvironmentOrchestration(diff); err != nil {
+		return err
+	}
+
 	if diff.Id() != "" {
 		// Update.
 
@@ -836,6 +840,35 @@ func waitComputeEnvironmentDeleted(ctx context.Context, conn *batch.Client, name
 	return nil, err
 }
 
+// validateComputeEnvironmentOrchestration ensures that EKS and ECS backed compute resources aren't mixed.
+func validateComputeEnvironmentOrchestration(diff *schema.ResourceDiff) error {
+	_, eks := diff.GetOk("eks_configuration")
+
+	if eks && isFargateType(awstypes.CRType(diff.Get("compute_resources.0.type").(string))) {
+		return errors.New("Fargate `compute_resources` can't be used with `eks_configuration`")
+	}
+
+	for i, v := range diff.Get("compute_resources.0.ec2_configuration").([]any) {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		imageType := tfMap["image_type"].(string)
+		if imageType == "" {
+			continue
+		}
+
+		if isEKSImageType := strings.HasPrefix(imageType, "EKS_"); eks && !isEKSImageType {
+			return fmt.Errorf("`compute_resources.0.ec2_configuration.%d.image_type` must be an EKS image type when `eks_configuration` is specified, got %q", i, imageType)
+		} else if !eks && isEKSImageType {
+			return fmt.Errorf("`compute_resources.0.ec2_configuration.%d.image_type` %q requires `eks_configuration`", i, imageType)
+		}
+	}
+
+	return nil
+}
+
 func isFargateType(computeResourceType awstypes.CRType) bool {
 	if computeResourceType == awstypes.CRTypeFargate || computeResourceType == awstypes.CRTypeFargateSpot {
 		return true
@@ -376,6 +376,28 @@ func TestAccBatchComputeEnvironment_eksConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccBatchComputeEnvironment_eksConfigurationOrchestrationMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeEnvironmentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeEnvironmentConfig_orchestrationMismatch(rName, true, "ECS_AL2023"),
+				ExpectError: regexache.MustCompile(`must be an EKS image type when ` + "`eks_configuration`" + ` is specified`),
+			},
+			{
+				Config:      testAccComputeEnvironmentConfig_orchestrationMismatch(rName, false, "EKS_AL2023"),
+				ExpectError: regexache.MustCompile(`"EKS_AL2023" requires ` + "`eks_configuration`"),
+			},
+		},
+	})
+}
+
 func TestAccBatchComputeEnvironment_createEC2(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ce awstypes.ComputeEnvironmentDetail
@@ -2285,6 +2307,43 @@ resource "aws_batch_compute_environment" "test" {
 `, rName))
 }
 
+func testAccComputeEnvironmentConfig_orchestrationMismatch(rName string, eks bool, imageType string) string {
+	var eksConfiguration string
+	if eks {
+		eksConfiguration = fmt.Sprintf(`
+  eks_configuration {
+    eks_cluster_arn      = "arn:${data.aws_partition.current.partition}:eks:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:cluster/%[1]s"
+    kubernetes_namespace = "test"
+  }
+`, rName)
+	}
+
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_batch_compute_environment" "test" {
+  name = %[1]q
+  type = "MANAGED"
+%[2]s
+  compute_resources {
+    instance_role = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:instance-profile/%[1]s"
+    instance_type = ["m5.large"]
+    max_vcpus     = 16
+    subnets       = ["subnet-12345678"]
+    type          = "EC2"
+
+    ec2_configuration {
+      image_type = %[3]q
+    }
+  }
+}
+`, rName, eksConfiguration, imageType)
+}
+
 func testAccComputeEnvironmentConfig_eksConfiguration(rName string) string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
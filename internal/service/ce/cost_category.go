@@ -7,6 +7,7 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -172,7 +173,32 @@ func resourceCostCategory() *schema.Resource {
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			}
 		},
+
+		CustomizeDiff: checkCostCategoryRules,
+	}
+}
+
+// checkCostCategoryRules verifies that each rule specifies exactly one of `rule` or `inherited_value`.
+func checkCostCategoryRules(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	for i, v := range d.Get(names.AttrRule).([]any) {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		hasRule := len(tfMap[names.AttrRule].([]any)) > 0
+		hasInheritedValue := len(tfMap["inherited_value"].([]any)) > 0
+
+		if hasRule == hasInheritedValue {
+			return fmt.Errorf("rule.%d: exactly one of `rule` or `inherited_value` must be specified", i)
+		}
 	}
+
+	return nil
 }
 
 func expressionElem(level int) *schema.Resource {
@@ -186,6 +186,24 @@ func TestAccCECostCategory_notWithAnd(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategory_ruleAndInheritedValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckPayerAccount(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostCategoryDestroy(ctx, t),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCostCategoryConfig_ruleAndInheritedValue(rName),
+				ExpectError: regexache.MustCompile("exactly one of `rule` or `inherited_value` must be specified"),
+			},
+		},
+	})
+}
+
 func TestAccCECostCategory_splitCharge(t *testing.T) {
 	ctx := acctest.Context(t)
 	var output awstypes.CostCategory
@@ -770,3 +788,26 @@ resource "aws_ce_cost_category" "test" {
 }
 `, rName)
 }
+
+func testAccCostCategoryConfig_ruleAndInheritedValue(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+  rule {
+    type = "INHERITED_VALUE"
+    inherited_value {
+      dimension_name = "TAG"
+      dimension_key  = "env"
+    }
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -6,8 +6,13 @@
 package cloudwatch
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/YakDriver/smarterr"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -50,7 +55,7 @@ func resourceDashboard() *schema.Resource {
 				Type:                  schema.TypeString,
 				Required:              true,
 				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc:      suppressEquivalentDashboardBodyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v any) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -63,6 +68,23 @@ func resourceDashboard() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validDashboardName,
 			},
+			"validate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+			if !d.Get("validate").(bool) || !d.NewValueKnown("dashboard_body") {
+				return nil
+			}
+
+			if err := validDashboardBody(d.Get("dashboard_body").(string)); err != nil {
+				return fmt.Errorf("invalid dashboard_body: %w", err)
+			}
+
+			return nil
 		},
 	}
 }
@@ -153,3 +175,123 @@ func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name stri
 
 	return output, nil
 }
+
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html#CloudWatch-Dashboard-Properties-Widgets-Structure.
+var dashboardWidgetTypes = []string{
+	"alarm",
+	"custom",
+	"explorer",
+	"log",
+	"metric",
+	"text",
+}
+
+func validDashboardBody(body string) error {
+	var tfMap map[string]any
+	if err := json.Unmarshal([]byte(body), &tfMap); err != nil {
+		return err
+	}
+
+	v, ok := tfMap["widgets"]
+	if !ok {
+		return errors.New(`"widgets" is required`)
+	}
+
+	widgets, ok := v.([]any)
+	if !ok {
+		return errors.New(`"widgets" must be an array`)
+	}
+
+	var errs []error
+
+	for i, v := range widgets {
+		widget, ok := v.(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("widgets[%d]: must be an object", i))
+			continue
+		}
+
+		if v, _ := widget["type"].(string); !slices.Contains(dashboardWidgetTypes, v) {
+			errs = append(errs, fmt.Errorf("widgets[%d]: invalid type %q, expected one of %q", i, v, dashboardWidgetTypes))
+		}
+
+		for _, k := range []string{"x", "y", "width", "height"} {
+			if _, ok := widget[k].(float64); !ok {
+				errs = append(errs, fmt.Errorf("widgets[%d]: %q is required and must be a number", i, k))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// suppressEquivalentDashboardBodyDiffs suppresses diffs between dashboard bodies that are equivalent JSON
+// or that differ only in the order of explicitly positioned widgets.
+func suppressEquivalentDashboardBodyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if verify.SuppressEquivalentJSONDiffs(k, old, new, d) {
+		return true
+	}
+
+	oldBody, err := normalizeDashboardBody(old)
+	if err != nil {
+		return false
+	}
+
+	newBody, err := normalizeDashboardBody(new)
+	if err != nil {
+		return false
+	}
+
+	return oldBody == newBody
+}
+
+// normalizeDashboardBody returns the canonical JSON form of a dashboard body.
+// Widgets are sorted only if every widget has an explicit position, as the order of
+// widgets without "x" and "y" determines their layout.
+func normalizeDashboardBody(body string) (string, error) {
+	var tfMap map[string]any
+	if err := json.Unmarshal([]byte(body), &tfMap); err != nil {
+		return "", err
+	}
+
+	if widgets, ok := tfMap["widgets"].([]any); ok {
+		type keyedWidget struct {
+			key    string
+			widget any
+		}
+
+		keyedWidgets := make([]keyedWidget, 0, len(widgets))
+		positioned := true
+
+		for _, v := range widgets {
+			if widget, ok := v.(map[string]any); !ok || widget["x"] == nil || widget["y"] == nil {
+				positioned = false
+				break
+			}
+
+			key, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+
+			keyedWidgets = append(keyedWidgets, keyedWidget{key: string(key), widget: v})
+		}
+
+		if positioned {
+			slices.SortFunc(keyedWidgets, func(a, b keyedWidget) int {
+				return cmp.Compare(a.key, b.key)
+			})
+
+			for i, v := range keyedWidgets {
+				widgets[i] = v.widget
+			}
+		}
+	}
+
+	output, err := json.Marshal(tfMap)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
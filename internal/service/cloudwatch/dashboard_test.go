@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -52,6 +54,47 @@ func TestAccCloudWatchDashboard_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_validate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardConfig_validate(rName, invalidWidget),
+				ExpectError: regexache.MustCompile(`invalid type "txt"`),
+			},
+			{
+				Config: testAccDashboardConfig_validate(rName, twoWidgets),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, t, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "validate", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate"},
+			},
+			{
+				Config: testAccDashboardConfig_validate(rName, twoWidgetsReordered),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchDashboard_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dashboard cloudwatch.GetDashboardOutput
@@ -139,6 +182,71 @@ const (
   ]
 }`
 
+	invalidWidget = `{
+  "widgets": [
+    {
+      "type": "txt",
+      "x": 0,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "Hi there from Terraform: CloudWatch"
+      }
+    }
+  ]
+}`
+
+	twoWidgets = `{
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "first"
+      }
+    },
+    {
+      "type": "text",
+      "x": 6,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "second"
+      }
+    }
+  ]
+}`
+
+	twoWidgetsReordered = `{
+  "widgets": [
+    {
+      "type": "text",
+      "x": 6,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "second"
+      }
+    },
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "first"
+      }
+    }
+  ]
+}`
+
 	updatedWidget = `{
   "widgets": [
     {
@@ -155,6 +263,88 @@ const (
 }`
 )
 
+func TestNormalizeDashboardBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b  string
+		equal bool
+	}{
+		"positioned widgets reordered": {
+			a:     twoWidgets,
+			b:     twoWidgetsReordered,
+			equal: true,
+		},
+		"unpositioned widgets reordered": {
+			a:     `{"widgets":[{"type":"text","properties":{"markdown":"a"}},{"type":"text","properties":{"markdown":"b"}}]}`,
+			b:     `{"widgets":[{"type":"text","properties":{"markdown":"b"}},{"type":"text","properties":{"markdown":"a"}}]}`,
+			equal: false,
+		},
+		"widgets changed": {
+			a:     basicWidget,
+			b:     updatedWidget,
+			equal: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := tfcloudwatch.NormalizeDashboardBody(testCase.a)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := tfcloudwatch.NormalizeDashboardBody(testCase.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := a == b, testCase.equal; got != want {
+				t.Errorf("equal = %t, want %t\n%s\n%s", got, want, a, b)
+			}
+		})
+	}
+}
+
+func TestValidDashboardBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body      string
+		expectErr bool
+	}{
+		"valid": {
+			body: twoWidgets,
+		},
+		"no widgets": {
+			body:      `{}`,
+			expectErr: true,
+		},
+		"invalid type": {
+			body:      invalidWidget,
+			expectErr: true,
+		},
+		"missing position": {
+			body:      `{"widgets":[{"type":"text","width":6,"height":6,"properties":{"markdown":"a"}}]}`,
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcloudwatch.ValidDashboardBody(testCase.body)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
 func testAccDashboardConfig_basic(rName, body string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_dashboard" "test" {
@@ -166,3 +356,16 @@ EOF
 }
 `, rName, body)
 }
+
+func testAccDashboardConfig_validate(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  validate       = true
+
+  dashboard_body = <<EOF
+  %[2]s
+EOF
+}
+`, rName, body)
+}
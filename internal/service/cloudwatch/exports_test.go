@@ -18,4 +18,6 @@ var (
 	FindMetricStreamByName                                     = findMetricStreamByName
 	FindContributorInsightRuleByName                           = findContributorInsightRuleByName
 	FindContributorManagedInsightRuleDescriptionByTemplateName = findContributorManagedInsightRuleDescriptionByTemplateName
+	NormalizeDashboardBody                                     = normalizeDashboardBody
+	ValidDashboardBody                                         = validDashboardBody
 )
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Cases domains are limited per account and Region, so all tests run serially.
+func TestAccConnectCases_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Domain": {
+			acctest.CtBasic:      testAccDomain_basic,
+			acctest.CtDisappears: testAccDomain_disappears,
+		},
+		"Field": {
+			acctest.CtBasic:      testAccField_basic,
+			acctest.CtDisappears: testAccField_disappears,
+			"update":             testAccField_update,
+		},
+		"Layout": {
+			acctest.CtBasic:      testAccLayout_basic,
+			acctest.CtDisappears: testAccLayout_disappears,
+		},
+		"Template": {
+			acctest.CtBasic:      testAccTemplate_basic,
+			acctest.CtDisappears: testAccTemplate_disappears,
+			"requiredFields":     testAccTemplate_requiredFields,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
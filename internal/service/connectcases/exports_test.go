@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+// Exports for use in tests only.
+var (
+	ResourceDomain   = newDomainResource
+	ResourceField    = newFieldResource
+	ResourceLayout   = newLayoutResource
+	ResourceTemplate = newTemplateResource
+
+	FindDomainByID           = findDomainByID
+	FindFieldByTwoPartKey    = findFieldByTwoPartKey
+	FindLayoutByTwoPartKey   = findLayoutByTwoPartKey
+	FindTemplateByTwoPartKey = findTemplateByTwoPartKey
+)
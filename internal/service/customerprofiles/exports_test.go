@@ -5,9 +5,11 @@ package customerprofiles
 
 // Exports for use in tests only.
 var (
-	ResourceDomain  = resourceDomain
-	ResourceProfile = resourceProfile
+	ResourceDomain            = resourceDomain
+	ResourceProfile           = resourceProfile
+	ResourceProfileObjectType = newProfileObjectTypeResource
 
-	FindDomainByDomainName  = findDomainByDomainName
-	FindProfileByTwoPartKey = findProfileByTwoPartKey
+	FindDomainByDomainName            = findDomainByDomainName
+	FindProfileByTwoPartKey           = findProfileByTwoPartKey
+	FindProfileObjectTypeByTwoPartKey = findProfileObjectTypeByTwoPartKey
 )
@@ -0,0 +1,517 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_customerprofiles_profile_object_type", name="Profile Object Type")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/customerprofiles;customerprofiles.GetProfileObjectTypeOutput")
+func newProfileObjectTypeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileObjectTypeResource{}, nil
+}
+
+type profileObjectTypeResource struct {
+	framework.ResourceWithModel[profileObjectTypeResourceModel]
+	framework.WithImportByID
+}
+
+func (r *profileObjectTypeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allow_profile_creation": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 10000),
+				},
+			},
+			names.AttrDomainName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encryption_key": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1098),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"max_available_profile_object_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_profile_object_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_last_updated_timestamp_format": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"template_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrField: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[objectTypeFieldModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrContentType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FieldContentType](),
+							Optional:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrSource: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrTarget: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrKey: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[objectTypeKeyModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_names": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"standard_identifiers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.StandardIdentifier](),
+							ElementType: fwtypes.StringEnumType[awstypes.StandardIdentifier](),
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileObjectTypeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileObjectTypeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CustomerProfilesClient(ctx)
+
+	domainName, objectTypeName := data.DomainName.ValueString(), data.ObjectTypeName.ValueString()
+	input, diags := data.expandPutProfileObjectTypeInput(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.PutProfileObjectType(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Customer Profiles Profile Object Type (%s/%s)", domainName, objectTypeName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating resource ID", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+	data.ARN = fwflex.StringValueToFramework(ctx, profileObjectTypeARN(ctx, r.Meta(), domainName, objectTypeName))
+	data.ExpirationDays = fwflex.Int32ToFrameworkInt64(ctx, output.ExpirationDays)
+	data.MaxAvailableProfileObjectCount = fwflex.Int32ToFrameworkInt64(ctx, output.MaxAvailableProfileObjectCount)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *profileObjectTypeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileObjectTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CustomerProfilesClient(ctx)
+
+	id := data.ID.ValueString()
+	domainName, objectTypeName := data.DomainName.ValueString(), data.ObjectTypeName.ValueString()
+	output, err := findProfileObjectTypeByTwoPartKey(ctx, conn, domainName, objectTypeName)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Customer Profiles Profile Object Type (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringValueToFramework(ctx, profileObjectTypeARN(ctx, r.Meta(), domainName, objectTypeName))
+
+	// Fields and keys are inherited from the template when template_id is set.
+	if data.TemplateID.IsNull() {
+		response.Diagnostics.Append(data.flattenFieldsAndKeys(ctx, output.Fields, output.Keys)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileObjectTypeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old profileObjectTypeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CustomerProfilesClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := new.ID.ValueString()
+		input, diags := new.expandPutProfileObjectTypeInput(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Tags = getTagsIn(ctx)
+
+		output, err := conn.PutProfileObjectType(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Customer Profiles Profile Object Type (%s)", id), err.Error())
+
+			return
+		}
+
+		new.ExpirationDays = fwflex.Int32ToFrameworkInt64(ctx, output.ExpirationDays)
+		new.MaxAvailableProfileObjectCount = fwflex.Int32ToFrameworkInt64(ctx, output.MaxAvailableProfileObjectCount)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileObjectTypeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileObjectTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CustomerProfilesClient(ctx)
+
+	id := data.ID.ValueString()
+	input := customerprofiles.DeleteProfileObjectTypeInput{
+		DomainName:     fwflex.StringFromFramework(ctx, data.DomainName),
+		ObjectTypeName: fwflex.StringFromFramework(ctx, data.ObjectTypeName),
+	}
+	_, err := conn.DeleteProfileObjectType(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Customer Profiles Profile Object Type (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func findProfileObjectTypeByTwoPartKey(ctx context.Context, conn *customerprofiles.Client, domainName, objectTypeName string) (*customerprofiles.GetProfileObjectTypeOutput, error) {
+	input := customerprofiles.GetProfileObjectTypeInput{
+		DomainName:     aws.String(domainName),
+		ObjectTypeName: aws.String(objectTypeName),
+	}
+	output, err := conn.GetProfileObjectType(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+// GetProfileObjectTypeOutput does not have an ARN attribute which is needed for Tagging, therefore we construct it.
+func profileObjectTypeARN(ctx context.Context, c *conns.AWSClient, domainName, objectTypeName string) string {
+	return c.RegionalARN(ctx, "profile", "domains/"+domainName+"/object-types/"+objectTypeName) // nosemgrep:ci.literal-profile-string-constant
+}
+
+type profileObjectTypeResourceModel struct {
+	framework.WithRegionModel
+	AllowProfileCreation             types.Bool                                           `tfsdk:"allow_profile_creation"`
+	ARN                              types.String                                         `tfsdk:"arn"`
+	Description                      types.String                                         `tfsdk:"description"`
+	DomainName                       types.String                                         `tfsdk:"domain_name"`
+	EncryptionKey                    types.String                                         `tfsdk:"encryption_key"`
+	ExpirationDays                   types.Int64                                          `tfsdk:"expiration_days"`
+	Fields                           fwtypes.SetNestedObjectValueOf[objectTypeFieldModel] `tfsdk:"field" autoflex:"-"`
+	ID                               types.String                                         `tfsdk:"id" autoflex:"-"`
+	Keys                             fwtypes.SetNestedObjectValueOf[objectTypeKeyModel]   `tfsdk:"key" autoflex:"-"`
+	MaxAvailableProfileObjectCount   types.Int64                                          `tfsdk:"max_available_profile_object_count"`
+	MaxProfileObjectCount            types.Int64                                          `tfsdk:"max_profile_object_count"`
+	ObjectTypeName                   types.String                                         `tfsdk:"object_type_name"`
+	SourceLastUpdatedTimestampFormat types.String                                         `tfsdk:"source_last_updated_timestamp_format"`
+	Tags                             tftags.Map                                           `tfsdk:"tags"`
+	TagsAll                          tftags.Map                                           `tfsdk:"tags_all"`
+	TemplateID                       types.String                                         `tfsdk:"template_id"`
+}
+
+const (
+	profileObjectTypeResourceIDPartCount = 2
+)
+
+func (m *profileObjectTypeResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), profileObjectTypeResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.DomainName = types.StringValue(parts[0])
+	m.ObjectTypeName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *profileObjectTypeResourceModel) setID() (string, error) {
+	parts := []string{
+		m.DomainName.ValueString(),
+		m.ObjectTypeName.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, profileObjectTypeResourceIDPartCount, false)
+}
+
+func (m *profileObjectTypeResourceModel) expandPutProfileObjectTypeInput(ctx context.Context) (*customerprofiles.PutProfileObjectTypeInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var input customerprofiles.PutProfileObjectTypeInput
+	diags.Append(fwflex.Expand(ctx, m, &input)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The API represents fields and keys as maps keyed by name.
+	fields, d := m.Fields.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(fields) > 0 {
+		input.Fields = make(map[string]awstypes.ObjectTypeField, len(fields))
+
+		for _, field := range fields {
+			var apiObject awstypes.ObjectTypeField
+			diags.Append(fwflex.Expand(ctx, field, &apiObject)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			input.Fields[field.Name.ValueString()] = apiObject
+		}
+	}
+
+	keys, d := m.Keys.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(keys) > 0 {
+		input.Keys = make(map[string][]awstypes.ObjectTypeKey, len(keys))
+
+		for _, key := range keys {
+			var apiObject awstypes.ObjectTypeKey
+			diags.Append(fwflex.Expand(ctx, key, &apiObject)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			name := key.Name.ValueString()
+			input.Keys[name] = append(input.Keys[name], apiObject)
+		}
+	}
+
+	return &input, diags
+}
+
+func (m *profileObjectTypeResourceModel) flattenFieldsAndKeys(ctx context.Context, apiFields map[string]awstypes.ObjectTypeField, apiKeys map[string][]awstypes.ObjectTypeKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := make([]objectTypeFieldModel, 0, len(apiFields))
+	for name, apiObject := range apiFields {
+		var field objectTypeFieldModel
+		diags.Append(fwflex.Flatten(ctx, apiObject, &field)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		field.Name = types.StringValue(name)
+		fields = append(fields, field)
+	}
+
+	keys := make([]objectTypeKeyModel, 0, len(apiKeys))
+	for name, apiObjects := range apiKeys {
+		for _, apiObject := range apiObjects {
+			var key objectTypeKeyModel
+			diags.Append(fwflex.Flatten(ctx, apiObject, &key)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			key.Name = types.StringValue(name)
+			keys = append(keys, key)
+		}
+	}
+
+	if len(fields) > 0 {
+		m.Fields = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, fields)
+	} else {
+		m.Fields = fwtypes.NewSetNestedObjectValueOfNull[objectTypeFieldModel](ctx)
+	}
+
+	if len(keys) > 0 {
+		m.Keys = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, keys)
+	} else {
+		m.Keys = fwtypes.NewSetNestedObjectValueOfNull[objectTypeKeyModel](ctx)
+	}
+
+	return diags
+}
+
+type objectTypeFieldModel struct {
+	ContentType fwtypes.StringEnum[awstypes.FieldContentType] `tfsdk:"content_type"`
+	Name        types.String                                  `tfsdk:"name" autoflex:"-"`
+	Source      types.String                                  `tfsdk:"source"`
+	Target      types.String                                  `tfsdk:"target"`
+}
+
+type objectTypeKeyModel struct {
+	FieldNames          fwtypes.ListOfString                                 `tfsdk:"field_names"`
+	Name                types.String                                         `tfsdk:"name" autoflex:"-"`
+	StandardIdentifiers fwtypes.SetOfStringEnum[awstypes.StandardIdentifier] `tfsdk:"standard_identifiers"`
+}
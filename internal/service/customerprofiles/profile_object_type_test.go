@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesProfileObjectType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	objectTypeName := acctest.RandStringFromCharSet(t, 10, acctest.CharSetAlpha)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileObjectTypeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileObjectTypeConfig_basic(rName, objectTypeName, "test object type"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_profile_creation", acctest.CtFalse),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "profile", "domains/{domain_name}/object-types/{object_type_name}"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test object type"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomainName, "aws_customerprofiles_domain.test", names.AttrDomainName),
+					resource.TestCheckResourceAttr(resourceName, "expiration_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "field.*", map[string]string{
+						names.AttrContentType: "STRING",
+						names.AttrName:        "Email",
+						names.AttrSource:      "_source.email",
+						names.AttrTarget:      "_profile.EmailAddress",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "key.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "key.*", map[string]string{
+						names.AttrName:           "_email",
+						"field_names.#":          "1",
+						"field_names.0":          "Email",
+						"standard_identifiers.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "object_type_name", objectTypeName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileObjectTypeConfig_basic(rName, objectTypeName, "updated object type"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated object type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesProfileObjectType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	objectTypeName := acctest.RandStringFromCharSet(t, 10, acctest.CharSetAlpha)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileObjectTypeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileObjectTypeConfig_basic(rName, objectTypeName, "test object type"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, t, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfcustomerprofiles.ResourceProfileObjectType, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesProfileObjectType_templateID(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	objectTypeName := acctest.RandStringFromCharSet(t, 10, acctest.CharSetAlpha)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileObjectTypeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileObjectTypeConfig_templateID(rName, objectTypeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_id", "Salesforce-Account"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfileObjectTypeExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).CustomerProfilesClient(ctx)
+
+		_, err := tfcustomerprofiles.FindProfileObjectTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes["object_type_name"])
+
+		return err
+	}
+}
+
+func testAccCheckProfileObjectTypeDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).CustomerProfilesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_profile_object_type" {
+				continue
+			}
+
+			_, err := tfcustomerprofiles.FindProfileObjectTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes["object_type_name"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Customer Profiles Profile Object Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProfileObjectTypeConfig_basic(rName, objectTypeName, description string) string {
+	return acctest.ConfigCompose(
+		testAccProfileConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = %[2]q
+  description      = %[3]q
+
+  field {
+    name         = "Email"
+    content_type = "STRING"
+    source       = "_source.email"
+    target       = "_profile.EmailAddress"
+  }
+
+  field {
+    name         = "Name"
+    content_type = "NAME"
+    source       = "_source.name"
+    target       = "_profile.FirstName"
+  }
+
+  key {
+    name                 = "_email"
+    field_names          = ["Email"]
+    standard_identifiers = ["PROFILE", "UNIQUE"]
+  }
+}
+`, rName, objectTypeName, description))
+}
+
+func testAccProfileObjectTypeConfig_templateID(rName, objectTypeName string) string {
+	return acctest.ConfigCompose(
+		testAccProfileConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = %[2]q
+  description      = "test object type"
+  template_id      = "Salesforce-Account"
+}
+`, rName, objectTypeName))
+}
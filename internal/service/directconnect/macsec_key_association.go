@@ -15,11 +15,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/directconnect/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -40,11 +42,27 @@ func resourceMacSecKeyAssociation() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cak": {
-				Type:         schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"ckn"},
+				ConflictsWith: []string{"cak_wo"},
+				ValidateFunc:  validation.StringMatch(regexache.MustCompile(`[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
+			},
+			"cak_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"cak"},
+				RequiredWith:  []string{"ckn", "cak_wo_version"},
+				ValidateFunc:  validation.StringMatch(regexache.MustCompile(`[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
+			},
+			"cak_wo_version": {
+				Type:         schema.TypeInt,
 				Optional:     true,
 				ForceNew:     true,
-				RequiredWith: []string{"ckn"},
-				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Fa-f]{64}$`), "Must be 64-character hex code string"),
+				RequiredWith: []string{"cak_wo"},
 			},
 			"ckn": {
 				Type:         schema.TypeString,
@@ -88,9 +106,19 @@ func resourceMacSecKeyAssociatioCreate(ctx context.Context, d *schema.ResourceDa
 		ConnectionId: aws.String(connectionID),
 	}
 
+	cakWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("cak_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	if v, ok := d.GetOk("ckn"); ok {
 		input.Cak = aws.String(d.Get("cak").(string))
 		input.Ckn = aws.String(v.(string))
+
+		if cakWO != "" {
+			input.Cak = aws.String(cakWO)
+		}
 	}
 
 	if v, ok := d.GetOk("secret_arn"); ok {
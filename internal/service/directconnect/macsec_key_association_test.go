@@ -11,8 +11,10 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfdirectconnect "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
@@ -51,6 +53,36 @@ func TestAccDirectConnectMacSecKeyAssociation_withCkn(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectMacSecKeyAssociation_withCknWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionID := acctest.SkipIfEnvVarNotSet(t, "DX_CONNECTION_ID")
+	resourceName := "aws_dx_macsec_key_association.test"
+	ckn := testAccMacSecGenerateHex()
+	cak := testAccMacSecGenerateHex()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMacSecKeyAssociationDestroy(ctx, t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacSecKeyAssociationConfig_withCknWriteOnly(ckn, cak, connectionID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMacSecKeyAssociationExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrConnectionID, connectionID),
+					resource.TestMatchResourceAttr(resourceName, "ckn", regexache.MustCompile(ckn)),
+					resource.TestCheckNoResourceAttr(resourceName, "cak_wo"),
+					resource.TestCheckResourceAttr(resourceName, "cak_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectMacSecKeyAssociation_withSecret(t *testing.T) {
 	ctx := acctest.Context(t)
 	connectionID := acctest.SkipIfEnvVarNotSet(t, "DX_CONNECTION_ID")
@@ -139,6 +171,17 @@ resource "aws_dx_macsec_key_association" "test" {
 `, ckn, cak, connectionID)
 }
 
+func testAccMacSecKeyAssociationConfig_withCknWriteOnly(ckn, cak, connectionID string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id  = %[3]q
+  ckn            = %[1]q
+  cak_wo         = %[2]q
+  cak_wo_version = 1
+}
+`, ckn, cak, connectionID)
+}
+
 // Can only be used with an EXISTING secrets created by previous association - cannot create secrets from scratch.
 func testAccMacSecKeyAssociationConfig_withSecret(secretARN, connectionID string) string {
 	return fmt.Sprintf(`
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_byoip_cidr_advertisement", name="BYOIP CIDR Advertisement")
+func resourceByoipCIDRAdvertisement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceByoipCIDRAdvertisementCreate,
+		ReadWithoutTimeout:   resourceByoipCIDRAdvertisementRead,
+		DeleteWithoutTimeout: resourceByoipCIDRAdvertisementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidIPv4CIDRNetworkAddress,
+					verify.ValidIPv6CIDRNetworkAddress,
+				),
+			},
+			"network_border_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceByoipCIDRAdvertisementCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	cidr := d.Get("cidr").(string)
+	input := ec2.AdvertiseByoipCidrInput{
+		Cidr: aws.String(cidr),
+	}
+
+	if v, ok := d.GetOk("asn"); ok {
+		input.Asn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_border_group"); ok {
+		input.NetworkBorderGroup = aws.String(v.(string))
+	}
+
+	_, err := conn.AdvertiseByoipCidr(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "advertising EC2 BYOIP CIDR (%s): %s", cidr, err)
+	}
+
+	d.SetId(cidr)
+
+	if _, err := waitByoipCIDRAdvertised(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 BYOIP CIDR (%s) advertise: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceByoipCIDRAdvertisementRead(ctx, d, meta)...)
+}
+
+func resourceByoipCIDRAdvertisementRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	byoipCIDR, err := findByoipCIDRByCIDR(ctx, conn, d.Id())
+
+	if err == nil {
+		if state := byoipCIDR.State; state != awstypes.ByoipCidrStateAdvertised && state != awstypes.ByoipCidrStatePendingAdvertising {
+			err = &retry.NotFoundError{
+				Message: string(state),
+			}
+		}
+	}
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		log.Printf("[WARN] EC2 BYOIP CIDR Advertisement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 BYOIP CIDR Advertisement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr", byoipCIDR.Cidr)
+	d.Set("network_border_group", byoipCIDR.NetworkBorderGroup)
+	d.Set(names.AttrState, byoipCIDR.State)
+
+	return diags
+}
+
+func resourceByoipCIDRAdvertisementDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Withdrawing EC2 BYOIP CIDR: %s", d.Id())
+	input := ec2.WithdrawByoipCidrInput{
+		Cidr: aws.String(d.Id()),
+	}
+	_, err := conn.WithdrawByoipCidr(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "withdrawing EC2 BYOIP CIDR (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitByoipCIDRWithdrawn(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 BYOIP CIDR (%s) withdraw: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The CIDR must already be provisioned to the account (e.g. via IPAM or ProvisionByoipCidr)
+// and must not be advertised, so this test is gated on an environment variable.
+func TestAccEC2BYOIPCIDRAdvertisement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "EC2_BYOIP_ADVERTISEMENT_CIDR"
+	cidr := os.Getenv(key)
+	if cidr == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	resourceName := "aws_ec2_byoip_cidr_advertisement.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBYOIPCIDRAdvertisementDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBYOIPCIDRAdvertisementConfig_basic(cidr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBYOIPCIDRAdvertisementExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidr),
+					resource.TestCheckResourceAttrSet(resourceName, "network_border_group"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ByoipCidrStateAdvertised)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBYOIPCIDRAdvertisementExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		output, err := tfec2.FindByoipCIDRByCIDR(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output.State != awstypes.ByoipCidrStateAdvertised {
+			return fmt.Errorf("EC2 BYOIP CIDR %s is in state %s", rs.Primary.ID, output.State)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBYOIPCIDRAdvertisementDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_byoip_cidr_advertisement" {
+				continue
+			}
+
+			output, err := tfec2.FindByoipCIDRByCIDR(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if output.State != awstypes.ByoipCidrStateProvisioned {
+				return fmt.Errorf("EC2 BYOIP CIDR %s is still advertised (%s)", rs.Primary.ID, output.State)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBYOIPCIDRAdvertisementConfig_basic(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_byoip_cidr_advertisement" "test" {
+  cidr = %[1]q
+}
+`, cidr)
+}
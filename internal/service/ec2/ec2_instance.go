@@ -386,16 +386,17 @@ func resourceInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"host_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"host_resource_group_arn"},
 			},
 			"host_resource_group_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"placement_group", "placement_group_id"},
+				ConflictsWith: []string{"host_id", "placement_group", "placement_group_id"},
 			},
 			"iam_instance_profile": {
 				Type:     schema.TypeString,
@@ -2017,6 +2018,32 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if d.HasChange("host_resource_group_arn") && !d.IsNewResource() {
+		// "IncorrectInstanceState: The instance '...' is not in a state from which it can be modified".
+		if err := stopInstance(ctx, conn, d.Id(), false, instanceStopTimeout); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := ec2.ModifyInstancePlacementInput{
+			InstanceId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("host_resource_group_arn"); ok {
+			input.HostResourceGroupArn = aws.String(v.(string))
+			input.Tenancy = awstypes.HostTenancyHost
+		}
+
+		_, err := conn.ModifyInstancePlacement(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): modifying placement: %s", d.Id(), err)
+		}
+
+		if err := startInstance(ctx, conn, d.Id(), true, instanceStartTimeout); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -1131,6 +1131,51 @@ func TestAccEC2Instance_dedicatedInstance(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_hostResourceGroupARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	// The AMI must be associated with a host-based License Manager license configuration.
+	amiID := acctest.SkipIfEnvVarNotSet(t, "TF_AWS_EC2_HOST_RESOURCE_GROUP_AMI_ID")
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_hostResourceGroupARN(rName, amiID, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "host_resource_group_arn", "aws_resourcegroups_group.test1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "host"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_hostResourceGroupARN(rName, amiID, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "host_resource_group_arn", "aws_resourcegroups_group.test2", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", string(awstypes.InstanceStateNameRunning)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_outpost(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -7980,6 +8025,106 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_hostResourceGroupARN(rName, amiID, groupResourceName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_vpcBase(rName, false, 1), fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test1" {
+  name = "%[1]s-1"
+
+  configuration {
+    type = "AWS::EC2::HostManagement"
+
+    parameters {
+      name   = "any-host-based-license-configuration"
+      values = ["true"]
+    }
+
+    parameters {
+      name   = "allowed-host-families"
+      values = ["m5"]
+    }
+
+    parameters {
+      name   = "auto-allocate-host"
+      values = ["true"]
+    }
+
+    parameters {
+      name   = "auto-release-host"
+      values = ["true"]
+    }
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name   = "allowed-resource-types"
+      values = ["AWS::EC2::Host"]
+    }
+
+    parameters {
+      name   = "deletion-protection"
+      values = ["UNLESS_EMPTY"]
+    }
+  }
+}
+
+resource "aws_resourcegroups_group" "test2" {
+  name = "%[1]s-2"
+
+  configuration {
+    type = "AWS::EC2::HostManagement"
+
+    parameters {
+      name   = "any-host-based-license-configuration"
+      values = ["true"]
+    }
+
+    parameters {
+      name   = "allowed-host-families"
+      values = ["m5"]
+    }
+
+    parameters {
+      name   = "auto-allocate-host"
+      values = ["true"]
+    }
+
+    parameters {
+      name   = "auto-release-host"
+      values = ["true"]
+    }
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name   = "allowed-resource-types"
+      values = ["AWS::EC2::Host"]
+    }
+
+    parameters {
+      name   = "deletion-protection"
+      values = ["UNLESS_EMPTY"]
+    }
+  }
+}
+
+resource "aws_instance" "test" {
+  ami                     = %[2]q
+  instance_type           = "m5.large"
+  subnet_id               = aws_subnet.test.id
+  tenancy                 = "host"
+  host_resource_group_arn = aws_resourcegroups_group.%[3]s.arn
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, amiID, groupResourceName))
+}
+
 func testAccInstanceConfig_outpost(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
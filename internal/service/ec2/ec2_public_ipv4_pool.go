@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_public_ipv4_pool", name="Public IPv4 Pool")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func resourcePublicIPv4Pool() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePublicIPv4PoolCreate,
+		ReadWithoutTimeout:   resourcePublicIPv4PoolRead,
+		UpdateWithoutTimeout: resourcePublicIPv4PoolUpdate,
+		DeleteWithoutTimeout: resourcePublicIPv4PoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_border_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"pool_address_ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"available_address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"first_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"total_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_available_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePublicIPv4PoolCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.CreatePublicIpv4PoolInput{
+		TagSpecifications: getTagSpecificationsIn(ctx, awstypes.ResourceTypeIpv4poolEc2),
+	}
+
+	if v, ok := d.GetOk("network_border_group"); ok {
+		input.NetworkBorderGroup = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePublicIpv4Pool(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Public IPv4 Pool: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.PoolId))
+
+	return append(diags, resourcePublicIPv4PoolRead(ctx, d, meta)...)
+}
+
+func resourcePublicIPv4PoolRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	pool, err := findPublicIPv4PoolByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		log.Printf("[WARN] EC2 Public IPv4 Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Public IPv4 Pool (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDescription, pool.Description)
+	d.Set("network_border_group", pool.NetworkBorderGroup)
+	if err := d.Set("pool_address_ranges", flattenPublicIPv4PoolRanges(pool.PoolAddressRanges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pool_address_ranges: %s", err)
+	}
+	d.Set("total_address_count", pool.TotalAddressCount)
+	d.Set("total_available_address_count", pool.TotalAvailableAddressCount)
+
+	setTagsOut(ctx, pool.Tags)
+
+	return diags
+}
+
+func resourcePublicIPv4PoolUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Tags only.
+	return resourcePublicIPv4PoolRead(ctx, d, meta)
+}
+
+func resourcePublicIPv4PoolDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Public IPv4 Pool: %s", d.Id())
+	input := ec2.DeletePublicIpv4PoolInput{
+		PoolId: aws.String(d.Id()),
+	}
+	_, err := conn.DeletePublicIpv4Pool(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPublicIpv4PoolIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Public IPv4 Pool (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
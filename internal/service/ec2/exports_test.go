@@ -10,6 +10,7 @@ var (
 	ResourceAMIFromInstance                               = resourceAMIFromInstance
 	ResourceAMILaunchPermission                           = resourceAMILaunchPermission
 	ResourceAvailabilityZoneGroup                         = resourceAvailabilityZoneGroup
+	ResourceByoipCIDRAdvertisement                        = resourceByoipCIDRAdvertisement
 	ResourceCapacityReservation                           = resourceCapacityReservation
 	ResourceCarrierGateway                                = resourceCarrierGateway
 	ResourceClientVPNAuthorizationRule                    = resourceClientVPNAuthorizationRule
@@ -67,6 +68,7 @@ var (
 	ResourceNetworkInterfaceSGAttachment                  = resourceNetworkInterfaceSGAttachment
 	ResourceNetworkPerformanceMetricSubscription          = resourceNetworkPerformanceMetricSubscription
 	ResourcePlacementGroup                                = resourcePlacementGroup
+	ResourcePublicIPv4Pool                                = resourcePublicIPv4Pool
 	ResourceRoute                                         = resourceRoute
 	ResourceRouteTable                                    = resourceRouteTable
 	ResourceRouteTableAssociation                         = resourceRouteTableAssociation
@@ -149,6 +151,7 @@ var (
 	ExpandIPPerms                                               = expandIPPerms
 	FindAllowedImagesSettings                                   = findAllowedImagesSettings
 	FindAvailabilityZones                                       = findAvailabilityZones
+	FindByoipCIDRByCIDR                                         = findByoipCIDRByCIDR
 	FindCapacityReservationByID                                 = findCapacityReservationByID
 	FindCarrierGatewayByID                                      = findCarrierGatewayByID
 	FindClientVPNAuthorizationRuleByThreePartKey                = findClientVPNAuthorizationRuleByThreePartKey
@@ -203,6 +206,7 @@ var (
 	FindNetworkInterfaceSecurityGroup                           = findNetworkInterfaceSecurityGroup
 	FindNetworkPerformanceMetricSubscriptionByFourPartKey       = findNetworkPerformanceMetricSubscriptionByFourPartKey
 	FindPlacementGroupByName                                    = findPlacementGroupByName
+	FindPublicIPv4PoolByID                                      = findPublicIPv4PoolByID
 	FindPublicIPv4Pools                                         = findPublicIPv4Pools
 	FindRouteByIPv4Destination                                  = findRouteByIPv4Destination
 	FindRouteByIPv6Destination                                  = findRouteByIPv6Destination
@@ -1221,6 +1221,41 @@ func findPublicIPv4PoolByID(ctx context.Context, conn *ec2.Client, id string) (*
 	return output, nil
 }
 
+func findByoipCIDRs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeByoipCidrsInput) ([]awstypes.ByoipCidr, error) {
+	var output []awstypes.ByoipCidr
+
+	pages := ec2.NewDescribeByoipCidrsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ByoipCidrs...)
+	}
+
+	return output, nil
+}
+
+func findByoipCIDRByCIDR(ctx context.Context, conn *ec2.Client, cidr string) (*awstypes.ByoipCidr, error) {
+	input := ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	output, err := findByoipCIDRs(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.ByoipCidr) bool {
+		return aws.ToString(v.Cidr) == cidr
+	})
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
 func findVolumeAttachment(ctx context.Context, conn *ec2.Client, volumeID, instanceID, deviceName string) (*awstypes.VolumeAttachment, error) {
 	input := ec2.DescribeVolumesInput{
 		Filters: newAttributeFilterList(map[string]string{
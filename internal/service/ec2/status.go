@@ -1172,6 +1172,22 @@ func statusIPAMPoolCIDR(conn *ec2.Client, cidrBlock, poolID, poolCIDRID string)
 	}
 }
 
+func statusByoipCIDR(conn *ec2.Client, cidr string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findByoipCIDRByCIDR(ctx, conn, cidr)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
 func statusIPAMResourceDiscovery(conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func(ctx context.Context) (any, string, error) {
 		output, err := findIPAMResourceDiscoveryByID(ctx, conn, id)
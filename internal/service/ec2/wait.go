@@ -1022,6 +1022,46 @@ func waitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.Client, poolCIDRID,
 	return nil, err
 }
 
+func waitByoipCIDRAdvertised(ctx context.Context, conn *ec2.Client, cidr string, timeout time.Duration) (*awstypes.ByoipCidr, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ByoipCidrStateProvisioned, awstypes.ByoipCidrStatePendingAdvertising),
+		Target:  enum.Slice(awstypes.ByoipCidrStateAdvertised),
+		Refresh: statusByoipCIDR(conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ByoipCidr); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitByoipCIDRWithdrawn(ctx context.Context, conn *ec2.Client, cidr string, timeout time.Duration) (*awstypes.ByoipCidr, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ByoipCidrStateAdvertised, awstypes.ByoipCidrStatePendingWithdrawal),
+		Target:  enum.Slice(awstypes.ByoipCidrStateProvisioned),
+		Refresh: statusByoipCIDR(conn, cidr),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ByoipCidr); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitIPAMPoolCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.IpamPool, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.IpamPoolStateCreateInProgress),
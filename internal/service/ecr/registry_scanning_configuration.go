@@ -7,6 +7,8 @@ package ecr
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -84,7 +86,38 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.ScanType](),
 			},
 		},
+
+		CustomizeDiff: customizeDiffRegistryScanningConfigurationRules,
+	}
+}
+
+func customizeDiffRegistryScanningConfigurationRules(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("scan_type") || !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	if types.ScanType(d.Get("scan_type").(string)) != types.ScanTypeBasic {
+		return nil
+	}
+
+	rules := d.Get(names.AttrRule).(*schema.Set).List()
+
+	if len(rules) > 1 {
+		return fmt.Errorf("at most 1 rule may be configured when scan_type is %q, got %d", types.ScanTypeBasic, len(rules))
 	}
+
+	for _, tfMapRaw := range rules {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if types.ScanFrequency(tfMap["scan_frequency"].(string)) == types.ScanFrequencyContinuousScan {
+			return errors.New(`scan_frequency "CONTINUOUS_SCAN" requires scan_type "ENHANCED"`)
+		}
+	}
+
+	return nil
 }
 
 func resourceRegistryScanningConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
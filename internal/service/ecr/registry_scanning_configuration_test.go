@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -22,6 +23,7 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		acctest.CtBasic: testAccRegistryScanningConfiguration_basic,
 		"update":        testAccRegistryScanningConfiguration_update,
+		"validation":    testAccRegistryScanningConfiguration_validation,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -144,6 +146,27 @@ func testAccRegistryScanningConfigurationExists(ctx context.Context, t *testing.
 	}
 }
 
+func testAccRegistryScanningConfiguration_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_basicContinuousScan(),
+				ExpectError: regexache.MustCompile(`scan_frequency "CONTINUOUS_SCAN" requires scan_type "ENHANCED"`),
+			},
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_basicTwoRules(),
+				ExpectError: regexache.MustCompile(`at most 1 rule may be configured when scan_type is "BASIC"`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationConfig_basic() string {
 	return `
 resource "aws_ecr_registry_scanning_configuration" "test" {
@@ -188,3 +211,40 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfig_basicContinuousScan() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
+
+func testAccRegistryScanningConfigurationConfig_basicTwoRules() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
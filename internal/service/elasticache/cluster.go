@@ -350,6 +350,7 @@ func resourceCluster() *schema.Resource {
 			clusterValidateNumCacheNodes,
 			clusterForceNewOnMemcachedNodeTypeChange,
 			clusterValidateMemcachedSnapshotIdentifier,
+			clusterValidateLogDeliveryConfigurations,
 		),
 	}
 }
@@ -1039,3 +1040,36 @@ func clusterValidateMemcachedSnapshotIdentifier(_ context.Context, diff *schema.
 	}
 	return errors.New(`engine "memcached" does not support final_snapshot_identifier`)
 }
+
+// clusterValidateLogDeliveryConfigurations validates that `log_delivery_configuration` is only used with Redis OSS or Valkey
+// and that each log type is configured at most once.
+func clusterValidateLogDeliveryConfigurations(_ context.Context, diff *schema.ResourceDiff, v any) error {
+	configs := diff.Get("log_delivery_configuration").(*schema.Set).List()
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if v, ok := diff.GetOk(names.AttrEngine); ok && v.(string) == engineMemcached {
+		return errors.New(`engine "memcached" does not support log_delivery_configuration`)
+	}
+
+	logTypes := make(map[string]bool)
+	for _, config := range configs {
+		tfMap, ok := config.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		logType := tfMap["log_type"].(string)
+		if logType == "" {
+			continue
+		}
+
+		if logTypes[logType] {
+			return fmt.Errorf("log_delivery_configuration: log_type %q can only be configured once", logType)
+		}
+		logTypes[logType] = true
+	}
+
+	return nil
+}
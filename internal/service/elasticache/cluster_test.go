@@ -971,6 +971,28 @@ func TestAccElastiCacheCluster_Memcached_finalSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheCluster_LogDeliveryConfigurations_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_logDeliveryConfigurationsEngine(rName, "memcached"),
+				ExpectError: regexache.MustCompile(`engine "memcached" does not support log_delivery_configuration`),
+			},
+			{
+				Config:      testAccClusterConfig_logDeliveryConfigurationsDuplicateLogType(rName),
+				ExpectError: regexache.MustCompile(`log_type "slow-log" can only be configured once`),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheCluster_Redis_finalSnapshot(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2059,6 +2081,49 @@ resource "aws_elasticache_cluster" "test" {
 `, rName)
 }
 
+func testAccClusterConfig_logDeliveryConfigurationsEngine(rName, engine string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id      = %[1]q
+  engine          = %[2]q
+  node_type       = "cache.t3.small"
+  num_cache_nodes = 1
+
+  log_delivery_configuration {
+    destination      = %[1]q
+    destination_type = "cloudwatch-logs"
+    log_format       = "json"
+    log_type         = "slow-log"
+  }
+}
+`, rName, engine)
+}
+
+func testAccClusterConfig_logDeliveryConfigurationsDuplicateLogType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id      = %[1]q
+  engine          = "redis"
+  node_type       = "cache.t3.small"
+  num_cache_nodes = 1
+
+  log_delivery_configuration {
+    destination      = %[1]q
+    destination_type = "cloudwatch-logs"
+    log_format       = "json"
+    log_type         = "slow-log"
+  }
+
+  log_delivery_configuration {
+    destination      = %[1]q
+    destination_type = "cloudwatch-logs"
+    log_format       = "text"
+    log_type         = "slow-log"
+  }
+}
+`, rName)
+}
+
 func testAccClusterConfig_redisFinalSnapshot(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_cluster" "test" {
@@ -900,9 +900,11 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		//
 		// 1. Shard configuration changes
 		// 2. Replica count increases
-		// 3. Standard updates
-		// 4. Auth token changes
-		// 5. Replica count decreases
+		// 3. Multi-AZ disablement, if automatic failover is also being disabled
+		// 4. Standard updates
+		// 5. Multi-AZ enablement, if automatic failover is also being enabled
+		// 6. Auth token changes
+		// 7. Replica count decreases
 		var updateFuncs []func() error
 
 		o, n := d.GetChange("num_cache_clusters")
@@ -920,6 +922,35 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			} // Replica count decreases are deferred until after all other modifications are made.
 		}
 
+		// Multi-AZ requires automatic failover, so when both change together Multi-AZ is
+		// modified separately: disabled before automatic failover, enabled after it.
+		var multiAZUpdateFunc func() error
+		if d.HasChange("automatic_failover_enabled") && d.HasChange("multi_az_enabled") {
+			multiAZInput := elasticache.ModifyReplicationGroupInput{
+				ApplyImmediately:   aws.Bool(true),
+				MultiAZEnabled:     aws.Bool(d.Get("multi_az_enabled").(bool)),
+				ReplicationGroupId: aws.String(d.Id()),
+			}
+
+			multiAZUpdateFunc = func() error {
+				_, err := conn.ModifyReplicationGroup(ctx, &multiAZInput)
+				// modifying to match out of band operations may result in this error
+				if errs.IsAErrorMessageContains[*awstypes.InvalidParameterCombinationException](err, "No modifications were requested") {
+					return nil
+				}
+
+				if err != nil {
+					return fmt.Errorf("modifying ElastiCache Replication Group (%s) Multi-AZ: %w", d.Id(), err)
+				}
+				return nil
+			}
+
+			if !d.Get("multi_az_enabled").(bool) {
+				updateFuncs = append(updateFuncs, multiAZUpdateFunc)
+				multiAZUpdateFunc = nil
+			}
+		}
+
 		requestUpdate := false
 		input := elasticache.ModifyReplicationGroupInput{
 			ApplyImmediately:   aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
@@ -1006,7 +1037,7 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
-		if d.HasChange("multi_az_enabled") {
+		if d.HasChange("multi_az_enabled") && !d.HasChange("automatic_failover_enabled") {
 			input.MultiAZEnabled = aws.Bool(d.Get("multi_az_enabled").(bool))
 			requestUpdate = true
 		}
@@ -1106,6 +1137,10 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			})
 		}
 
+		if multiAZUpdateFunc != nil {
+			updateFuncs = append(updateFuncs, multiAZUpdateFunc)
+		}
+
 		if d.HasChanges("auth_token", "auth_token_update_strategy") {
 			// AuthTokenUpdateStrategyTypeDelete only supported while transitioning to RBAC.
 			if awstypes.AuthTokenUpdateStrategyType(d.Get("auth_token_update_strategy").(string)) != awstypes.AuthTokenUpdateStrategyTypeDelete {
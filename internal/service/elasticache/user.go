@@ -7,6 +7,8 @@ package elasticache
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -50,6 +52,8 @@ func resourceUser() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: resourceUserCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"access_string": {
 				Type:     schema.TypeString,
@@ -210,7 +214,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 		tfMap := map[string]any{
 			"password_count": aws.ToInt32(v.PasswordCount),
 			"passwords":      d.Get("authentication_mode.0.passwords"),
-			names.AttrType:   string(v.Type),
+			names.AttrType:   flattenAuthenticationType(v.Type),
 		}
 
 		if err := d.Set("authentication_mode", []any{tfMap}); err != nil {
@@ -249,12 +253,16 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.Engine = aws.String(d.Get(names.AttrEngine).(string))
 		}
 
-		if d.HasChange("no_password_required") {
-			input.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
-		}
+		// The legacy password arguments can't be combined with an authentication mode change,
+		// e.g. when transitioning between "password" and "iam" authentication. Setting them is rejected at plan time.
+		if input.AuthenticationMode == nil {
+			if d.HasChange("no_password_required") {
+				input.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
+			}
 
-		if d.HasChange("passwords") {
-			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
+			if d.HasChange("passwords") {
+				input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
+			}
 		}
 
 		_, err := conn.ModifyUser(ctx, input)
@@ -415,6 +423,30 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.Client, id string, t
 	return nil, err
 }
 
+func resourceUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if v := diff.Get("authentication_mode.0.type").(string); v == string(awstypes.InputAuthenticationTypeIam) {
+		// IAM authentication requires that the user ID and user name are identical.
+		if diff.NewValueKnown("user_id") && diff.NewValueKnown(names.AttrUserName) {
+			if userID, userName := diff.Get("user_id").(string), diff.Get(names.AttrUserName).(string); userID != userName {
+				return fmt.Errorf("user_name (%s) must be the same as user_id (%s) when authentication_mode.type is %q", userName, userID, v)
+			}
+		}
+	}
+
+	// The legacy password arguments can't be modified in the same request as authentication_mode.
+	if diff.Id() != "" && diff.HasChange("authentication_mode") {
+		if diff.HasChange("passwords") && diff.Get("passwords").(*schema.Set).Len() > 0 {
+			return errors.New(`"passwords" can't be changed together with "authentication_mode", use "authentication_mode.passwords" instead`)
+		}
+
+		if diff.HasChange("no_password_required") && diff.Get("no_password_required").(bool) {
+			return errors.New(`"no_password_required" can't be changed together with "authentication_mode", use "authentication_mode.type" instead`)
+		}
+	}
+
+	return nil
+}
+
 func expandAuthenticationMode(tfMap map[string]any) *awstypes.AuthenticationMode {
 	if tfMap == nil {
 		return nil
@@ -432,3 +464,12 @@ func expandAuthenticationMode(tfMap map[string]any) *awstypes.AuthenticationMode
 
 	return apiObject
 }
+
+// flattenAuthenticationType converts the returned authentication type to the corresponding input value.
+func flattenAuthenticationType(v awstypes.AuthenticationType) string {
+	if v == awstypes.AuthenticationTypeNoPassword {
+		return string(awstypes.InputAuthenticationTypeNoPasswordRequired)
+	}
+
+	return string(v)
+}
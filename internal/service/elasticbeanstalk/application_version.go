@@ -49,6 +49,11 @@ func resourceApplicationVersion() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"delete_source_bundle": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -73,6 +78,10 @@ func resourceApplicationVersion() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -125,6 +134,7 @@ func resourceApplicationVersionRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set(names.AttrARN, applicationVersion.ApplicationVersionArn)
 	d.Set(names.AttrDescription, applicationVersion.Description)
+	d.Set(names.AttrStatus, applicationVersion.Status)
 
 	return diags
 }
@@ -182,7 +192,7 @@ func resourceApplicationVersionDelete(ctx context.Context, d *schema.ResourceDat
 
 	_, err := conn.DeleteApplicationVersion(ctx, &elasticbeanstalk.DeleteApplicationVersionInput{
 		ApplicationName:    aws.String(applicationName),
-		DeleteSourceBundle: aws.Bool(false),
+		DeleteSourceBundle: aws.Bool(d.Get("delete_source_bundle").(bool)),
 		VersionLabel:       aws.String(d.Id()),
 	})
 
@@ -135,6 +135,37 @@ func TestAccElasticBeanstalkApplicationVersion_BeanstalkApp_process(t *testing.T
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationVersionExists(ctx, t, resourceName, &appVersion),
 					testAccCheckApplicationVersionMatchStatus(&appVersion, awstypes.ApplicationVersionStatusUnprocessed),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ApplicationVersionStatusUnprocessed)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkApplicationVersion_BeanstalkApp_deleteSourceBundle(t *testing.T) {
+	ctx := acctest.Context(t)
+	var appVersion awstypes.ApplicationVersionDescription
+	resourceName := "aws_elastic_beanstalk_application_version.default"
+	randInt := acctest.RandInt(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationVersionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationVersionConfig_deleteSourceBundle(randInt, acctest.CtFalse),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationVersionExists(ctx, t, resourceName, &appVersion),
+					resource.TestCheckResourceAttr(resourceName, "delete_source_bundle", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccApplicationVersionConfig_deleteSourceBundle(randInt, acctest.CtTrue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationVersionExists(ctx, t, resourceName, &appVersion),
+					resource.TestCheckResourceAttr(resourceName, "delete_source_bundle", acctest.CtTrue),
 				),
 			},
 		},
@@ -351,3 +382,31 @@ resource "aws_elastic_beanstalk_application_version" "default" {
 }
 `, randInt, randInt, randInt, process)
 }
+
+func testAccApplicationVersionConfig_deleteSourceBundle(randInt int, deleteSourceBundle string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "default" {
+  bucket        = "tftest.applicationversion.bucket-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "default" {
+  bucket = aws_s3_bucket.default.id
+  key    = "beanstalk/python-v1.zip"
+  source = "test-fixtures/python-v1.zip"
+}
+
+resource "aws_elastic_beanstalk_application" "default" {
+  name        = "tf-test-name-%[1]d"
+  description = "tf-test-desc"
+}
+
+resource "aws_elastic_beanstalk_application_version" "default" {
+  application          = aws_elastic_beanstalk_application.default.name
+  name                 = "tf-test-version-label-%[1]d"
+  bucket               = aws_s3_object.default.bucket
+  key                  = aws_s3_object.default.key
+  delete_source_bundle = %[2]s
+}
+`, randInt, deleteSourceBundle)
+}
@@ -420,6 +420,10 @@ func resourceListener() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"trust_store_association_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -1638,6 +1642,9 @@ func flattenMutualAuthenticationAttributes(apiObject *awstypes.MutualAuthenticat
 		if apiObject.TrustStoreArn != nil {
 			tfMap["trust_store_arn"] = aws.ToString(apiObject.TrustStoreArn)
 		}
+		if v := apiObject.TrustStoreAssociationStatus; v != "" {
+			tfMap["trust_store_association_status"] = v
+		}
 
 		return []any{tfMap}
 
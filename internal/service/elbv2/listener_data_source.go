@@ -322,6 +322,10 @@ func dataSourceListener() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"trust_store_association_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -56,6 +56,7 @@ func resourceLoadBalancer() *schema.Resource {
 			customizeDiffLoadBalancerALB,
 			customizeDiffLoadBalancerNLB,
 			customizeDiffLoadBalancerGWLB,
+			customizeDiffLoadBalancerEnforcePrivateLinkInboundRules,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -1452,6 +1453,25 @@ func customizeDiffLoadBalancerNLB(_ context.Context, diff *schema.ResourceDiff,
 	return nil
 }
 
+func customizeDiffLoadBalancerEnforcePrivateLinkInboundRules(_ context.Context, diff *schema.ResourceDiff, v any) error {
+	config := diff.GetRawConfig()
+
+	if v := config.GetAttr("enforce_security_group_inbound_rules_on_private_link_traffic"); !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	// The argument is ignored for other load balancer types.
+	if lbType := awstypes.LoadBalancerTypeEnum(diff.Get("load_balancer_type").(string)); lbType != awstypes.LoadBalancerTypeEnumNetwork {
+		return nil
+	}
+
+	if v := config.GetAttr(names.AttrSecurityGroups); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+		return errors.New("`enforce_security_group_inbound_rules_on_private_link_traffic` requires `security_groups` to be set")
+	}
+
+	return nil
+}
+
 func customizeDiffLoadBalancerALB(_ context.Context, diff *schema.ResourceDiff, v any) error {
 	if lbType := awstypes.LoadBalancerTypeEnum(diff.Get("load_balancer_type").(string)); lbType != awstypes.LoadBalancerTypeEnumApplication {
 		return nil
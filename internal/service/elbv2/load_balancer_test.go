@@ -2047,6 +2047,32 @@ func TestAccELBV2LoadBalancer_NetworkLoadBalancer_enforcePrivateLink(t *testing.
 	})
 }
 
+func TestAccELBV2LoadBalancer_NetworkLoadBalancer_enforcePrivateLinkValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.LoadBalancer
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoadBalancerConfig_enforcePrivateLinkNoSecurityGroups(rName, "network"),
+				ExpectError: regexache.MustCompile("`enforce_security_group_inbound_rules_on_private_link_traffic` requires\\s+`security_groups`"),
+			},
+			{
+				Config: testAccLoadBalancerConfig_enforcePrivateLinkNoSecurityGroups(rName, "application"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, t, "aws_lb.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb.test", "load_balancer_type", "application"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2LoadBalancer_NetworkLoadBalancer_addSubnet(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pre, post awstypes.LoadBalancer
@@ -3827,6 +3853,19 @@ resource "aws_lb" "test" {
 `, rName, n, enforcePrivateLink))
 }
 
+func testAccLoadBalancerConfig_enforcePrivateLinkNoSecurityGroups(rName, lbType string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  internal           = true
+  load_balancer_type = %[2]q
+  name               = %[1]q
+  subnets            = aws_subnet.test[*].id
+
+  enforce_security_group_inbound_rules_on_private_link_traffic = "on"
+}
+`, rName, lbType))
+}
+
 func testAccLoadBalancerConfig_nlbSubnetCount(rName string, subnetCount int) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, subnetCount), fmt.Sprintf(`
 resource "aws_lb" "test" {
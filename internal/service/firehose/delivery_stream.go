@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
 	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1317,9 +1318,23 @@ func resourceDeliveryStream() *schema.Resource {
 								ValidateFunc: validation.StringLenBetween(1, 255),
 							},
 							names.AttrPrivateKey: {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"snowflake_configuration.0.private_key_wo"},
+							},
+							"private_key_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"snowflake_configuration.0.private_key"},
+								RequiredWith:  []string{"snowflake_configuration.0.private_key_wo_version"},
+							},
+							"private_key_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"snowflake_configuration.0.private_key_wo"},
 							},
 							"processing_configuration": processingConfigurationSchema(),
 							"retry_duration": {
@@ -1484,6 +1499,8 @@ func resourceDeliveryStream() *schema.Resource {
 	}
 }
 
+var snowflakePrivateKeyWOPath = cty.GetAttrPath("snowflake_configuration").IndexInt(0).GetAttr("private_key_wo")
+
 func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FirehoseClient(ctx)
@@ -1535,6 +1552,16 @@ func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, m
 	case destinationTypeSnowflake:
 		if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.SnowflakeDestinationConfiguration = expandSnowflakeDestinationConfiguration(v.([]any)[0].(map[string]any))
+
+			privateKeyWO, di := flex.GetWriteOnlyStringValue(d, snowflakePrivateKeyWOPath)
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if privateKeyWO != "" {
+				input.SnowflakeDestinationConfiguration.PrivateKey = aws.String(privateKeyWO)
+			}
 		}
 	case destinationTypeSplunk:
 		if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -1666,7 +1693,8 @@ func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, met
 			d.Set(names.AttrDestination, destinationTypeSnowflake)
 			configuredKeyPassphrase := d.Get("snowflake_configuration.0.key_passphrase").(string)
 			configuredPrivateKey := d.Get("snowflake_configuration.0.private_key").(string)
-			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey)); err != nil {
+			configuredPrivateKeyWOVersion := d.Get("snowflake_configuration.0.private_key_wo_version").(int)
+			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey, configuredPrivateKeyWOVersion)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting snowflake_configuration: %s", err)
 			}
 		case destination.SplunkDestinationDescription != nil:
@@ -1731,6 +1759,16 @@ func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, m
 		case destinationTypeSnowflake:
 			if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.SnowflakeDestinationUpdate = expandSnowflakeDestinationUpdate(v.([]any)[0].(map[string]any))
+
+				privateKeyWO, di := flex.GetWriteOnlyStringValue(d, snowflakePrivateKeyWOPath)
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if privateKeyWO != "" {
+					input.SnowflakeDestinationUpdate.PrivateKey = aws.String(privateKeyWO)
+				}
 			}
 		case destinationTypeSplunk:
 			if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -3789,7 +3827,7 @@ func flattenRedshiftDestinationDescription(apiObject *types.RedshiftDestinationD
 	return []any{tfMap}
 }
 
-func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string) []any {
+func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string, configuredPrivateKeyWOVersion int) []any {
 	if apiObject == nil {
 		return []any{}
 	}
@@ -3804,6 +3842,7 @@ func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinatio
 		"key_passphrase":                configuredKeyPassphrase,
 		"metadata_column_name":          aws.ToString(apiObject.MetaDataColumnName),
 		names.AttrPrivateKey:            configuredPrivateKey,
+		"private_key_wo_version":        configuredPrivateKeyWOVersion,
 		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeSnowflake, roleARN),
 		names.AttrRoleARN:               roleARN,
 		"s3_backup_mode":                apiObject.S3BackupMode,
@@ -14,9 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
 	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tffirehose "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
@@ -1352,6 +1354,45 @@ func TestAccFirehoseDeliveryStream_Redshift_SecretsManagerConfiguration(t *testi
 	})
 }
 
+func TestAccFirehoseDeliveryStream_snowflakePrivateKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stream types.DeliveryStreamDescription
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	key1 := acctest.TLSRSAPrivateKeyPEM(t, 4096)
+	key2 := acctest.TLSRSAPrivateKeyPEM(t, 4096)
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.FirehoseServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryStreamDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryStreamConfig_snowflakePrivateKeyWriteOnly(rName, key1, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, t, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.private_key", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "snowflake_configuration.0.private_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.private_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDeliveryStreamConfig_snowflakePrivateKeyWriteOnly(rName, key2, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, t, resourceName, &stream),
+					resource.TestCheckNoResourceAttr(resourceName, "snowflake_configuration.0.private_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "snowflake_configuration.0.private_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFirehoseDeliveryStream_snowflakeUpdates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stream types.DeliveryStreamDescription
@@ -4532,6 +4573,32 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName))
 }
 
+func testAccDeliveryStreamConfig_snowflakePrivateKeyWriteOnly(rName, privateKey string, privateKeyVersion int) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose]
+  name        = %[1]q
+  destination = "snowflake"
+
+  snowflake_configuration {
+    account_url            = "https://%[1]s.snowflakecomputing.com"
+    database               = "test-db"
+    private_key_wo         = "%[2]s"
+    private_key_wo_version = %[3]d
+    role_arn               = aws_iam_role.firehose.arn
+    schema                 = "test-schema"
+    table                  = "test-table"
+    user                   = "test-usr"
+
+    s3_configuration {
+      role_arn   = aws_iam_role.firehose.arn
+      bucket_arn = aws_s3_bucket.bucket.arn
+    }
+  }
+}
+`, rName, acctest.TLSPEMRemoveRSAPrivateKeyEncapsulationBoundaries(acctest.TLSPEMRemoveNewlines(privateKey)), privateKeyVersion))
+}
+
 func testAccDeliveryStreamConfig_snowflakeBasic(rName, privateKey string) string {
 	return acctest.ConfigCompose(testAccDeliveryStreamConfig_base(rName), fmt.Sprintf(`
 resource "aws_kinesis_firehose_delivery_stream" "test" {
@@ -225,6 +225,28 @@ func TestAccGlueCatalogDatabase_federatedDatabase(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogDatabase_targetDatabaseConflicts(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCatalogDatabaseConfig_targetAndFederated(rName),
+				ExpectError: regexache.MustCompile(`"target_database": conflicts with federated_database`),
+			},
+			{
+				Config:      testAccCatalogDatabaseConfig_targetAndLocationURI(rName),
+				ExpectError: regexache.MustCompile(`"target_database": conflicts with location_uri`),
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogDatabase_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_glue_catalog_database.test"
@@ -488,6 +510,38 @@ resource "aws_glue_catalog_database" "test2" {
 `, rName)
 }
 
+func testAccCatalogDatabaseConfig_targetAndFederated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  target_database {
+    catalog_id    = "123456789012"
+    database_name = "%[1]s-2"
+  }
+
+  federated_database {
+    connection_name = "aws:redshift"
+    identifier      = "%[1]s-3"
+  }
+}
+`, rName)
+}
+
+func testAccCatalogDatabaseConfig_targetAndLocationURI(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name         = %[1]q
+  location_uri = "my-location"
+
+  target_database {
+    catalog_id    = "123456789012"
+    database_name = "%[1]s-2"
+  }
+}
+`, rName)
+}
+
 func testAccCatalogDatabaseConfig_targetWithRegion(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
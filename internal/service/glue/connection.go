@@ -54,6 +54,75 @@ func resourceConnection() *schema.Resource {
 				Sensitive: true,
 				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
+			"authentication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AuthenticationType](),
+						},
+						"oauth2_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"oauth2_client_application": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"aws_managed_client_application_reference": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 2048),
+												},
+												"user_managed_client_application_client_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 2048),
+												},
+											},
+										},
+									},
+									"oauth2_grant_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.OAuth2GrantType](),
+									},
+									"token_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"token_url_parameters_map": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"secret_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			names.AttrCatalogID: {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -175,6 +244,9 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta an
 
 	d.Set(names.AttrARN, connectionARN(ctx, c, connectionName))
 	d.Set("athena_properties", connection.AthenaProperties)
+	if err := d.Set("authentication_configuration", flattenAuthenticationConfiguration(connection.AuthenticationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting authentication_configuration: %s", err)
+	}
 	d.Set(names.AttrCatalogID, catalogID)
 	d.Set("connection_properties", connection.ConnectionProperties)
 	d.Set("connection_type", connection.ConnectionType)
@@ -303,6 +375,10 @@ func expandConnectionInput(d *schema.ResourceData) *awstypes.ConnectionInput {
 		apiObject.AthenaProperties = flex.ExpandStringValueMap(v.(map[string]any))
 	}
 
+	if v, ok := d.GetOk("authentication_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		apiObject.AuthenticationConfiguration = expandAuthenticationConfigurationInput(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("connection_properties"); ok && len(v.(map[string]any)) > 0 {
 		apiObject.ConnectionProperties = flex.ExpandStringValueMap(v.(map[string]any))
 	} else {
@@ -324,6 +400,98 @@ func expandConnectionInput(d *schema.ResourceData) *awstypes.ConnectionInput {
 	return apiObject
 }
 
+func expandAuthenticationConfigurationInput(tfMap map[string]any) *awstypes.AuthenticationConfigurationInput {
+	apiObject := &awstypes.AuthenticationConfigurationInput{}
+
+	if v, ok := tfMap["authentication_type"].(string); ok && v != "" {
+		apiObject.AuthenticationType = awstypes.AuthenticationType(v)
+	}
+
+	if v, ok := tfMap["oauth2_properties"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OAuth2Properties = expandOAuth2PropertiesInput(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOAuth2PropertiesInput(tfMap map[string]any) *awstypes.OAuth2PropertiesInput {
+	apiObject := &awstypes.OAuth2PropertiesInput{}
+
+	if v, ok := tfMap["oauth2_client_application"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OAuth2ClientApplication = expandOAuth2ClientApplication(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["oauth2_grant_type"].(string); ok && v != "" {
+		apiObject.OAuth2GrantType = awstypes.OAuth2GrantType(v)
+	}
+
+	if v, ok := tfMap["token_url"].(string); ok && v != "" {
+		apiObject.TokenUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["token_url_parameters_map"].(map[string]any); ok && len(v) > 0 {
+		apiObject.TokenUrlParametersMap = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandOAuth2ClientApplication(tfMap map[string]any) *awstypes.OAuth2ClientApplication {
+	apiObject := &awstypes.OAuth2ClientApplication{}
+
+	if v, ok := tfMap["aws_managed_client_application_reference"].(string); ok && v != "" {
+		apiObject.AWSManagedClientApplicationReference = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_managed_client_application_client_id"].(string); ok && v != "" {
+		apiObject.UserManagedClientApplicationClientId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAuthenticationConfiguration(apiObject *awstypes.AuthenticationConfiguration) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"authentication_type": apiObject.AuthenticationType,
+		"secret_arn":          aws.ToString(apiObject.SecretArn),
+	}
+
+	if v := apiObject.OAuth2Properties; v != nil {
+		tfMap["oauth2_properties"] = flattenOAuth2Properties(v)
+	}
+
+	return []any{tfMap}
+}
+
+func flattenOAuth2Properties(apiObject *awstypes.OAuth2Properties) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"oauth2_grant_type":        apiObject.OAuth2GrantType,
+		"token_url":                aws.ToString(apiObject.TokenUrl),
+		"token_url_parameters_map": apiObject.TokenUrlParametersMap,
+	}
+
+	if v := apiObject.OAuth2ClientApplication; v != nil {
+		tfMap["oauth2_client_application"] = []any{map[string]any{
+			"aws_managed_client_application_reference":  aws.ToString(v.AWSManagedClientApplicationReference),
+			"user_managed_client_application_client_id": aws.ToString(v.UserManagedClientApplicationClientId),
+		}}
+	}
+
+	return []any{tfMap}
+}
+
 func expandPhysicalConnectionRequirements(tfMap map[string]any) *awstypes.PhysicalConnectionRequirements {
 	apiObject := &awstypes.PhysicalConnectionRequirements{}
 
@@ -357,9 +525,14 @@ func flattenPhysicalConnectionRequirements(apiObject *awstypes.PhysicalConnectio
 }
 
 func connectionPropertyKey_Values() []string {
-	return tfslices.AppendUnique(enum.Values[awstypes.ConnectionPropertyKey](), "SparkProperties")
+	return tfslices.AppendUnique(enum.Values[awstypes.ConnectionPropertyKey](), "INSTANCE_URL", "ROLE_ARN", "SparkProperties")
 }
 
 func connectionType_Values() []string {
-	return tfslices.AppendUnique(enum.Values[awstypes.ConnectionType](), "AZURECOSMOS", "AZURESQL", "BIGQUERY", "DYNAMODB", "OPENSEARCH", "SNOWFLAKE")
+	return tfslices.AppendUnique(enum.Values[awstypes.ConnectionType](), "AZURECOSMOS", "AZURESQL", "BIGQUERY", "DYNAMODB", "OPENSEARCH", "SNOWFLAKE",
+		// SaaS connector types.
+		"ADOBEANALYTICS", "ASANA", "DATADOG", "FACEBOOKADS", "GOOGLEADS", "GOOGLEANALYTICS4", "GOOGLESHEETS", "HUBSPOT",
+		"INSTAGRAMADS", "INTERCOM", "JIRACLOUD", "MARKETO", "NETSUITEERP", "SALESFORCE", "SALESFORCEMARKETINGCLOUD",
+		"SALESFORCEPARDOT", "SAPODATA", "SERVICENOW", "SLACK", "SNAPCHATADS", "STRIPE", "ZENDESK", "ZOHOCRM",
+	)
 }
@@ -11,6 +11,7 @@ import (
 
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -563,6 +564,49 @@ func TestAccGlueConnection_snowflake(t *testing.T) {
 	})
 }
 
+func TestAccGlueConnection_salesforce(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection awstypes.Connection
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_glue_connection.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_salesforce(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, t, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.authentication_type", "OAUTH2"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.oauth2_grant_type", "JWT_BEARER"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.oauth2_client_application.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.oauth2_properties.0.oauth2_client_application.0.user_managed_client_application_client_id", "testclientid"),
+					resource.TestCheckResourceAttrPair(resourceName, "authentication_configuration.0.secret_arn", "aws_secretsmanager_secret.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "SALESFORCE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectionConfig_salesforce(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckConnectionExists(ctx context.Context, t *testing.T, n string, v *awstypes.Connection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1061,6 +1105,62 @@ resource "aws_glue_connection" "test" {
 `, rName, sfUrl)
 }
 
+func testAccConnectionConfig_salesforce(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    JWT_TOKEN = "testjwttoken"
+  })
+}
+
+resource "aws_glue_connection" "test" {
+  name = %[1]q
+
+  connection_type = "SALESFORCE"
+  connection_properties = {
+    INSTANCE_URL = "https://%[1]s.my.salesforce.com"
+    ROLE_ARN     = aws_iam_role.test.arn
+  }
+
+  authentication_configuration {
+    authentication_type = "OAUTH2"
+    secret_arn          = aws_secretsmanager_secret.test.arn
+
+    oauth2_properties {
+      oauth2_grant_type = "JWT_BEARER"
+      token_url         = "https://login.salesforce.com/services/oauth2/token"
+
+      oauth2_client_application {
+        user_managed_client_application_client_id = "testclientid"
+      }
+    }
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName)
+}
+
 func testAccConnectionConfig_dynamoDB(rName, region, bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
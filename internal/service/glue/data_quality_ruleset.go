@@ -11,6 +11,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -69,9 +71,12 @@ func resourceDataQualityRuleset() *schema.Resource {
 				Computed: true,
 			},
 			"ruleset": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 65536),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 65536),
+					validateDataQualityRuleset,
+				),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -89,16 +94,18 @@ func resourceDataQualityRuleset() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(1, 255),
 						},
 						names.AttrDatabaseName: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringLenBetween(1, 255),
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringLenBetween(1, 255),
+							DiffSuppressFunc: sdkv2.SuppressEquivalentStringCaseInsensitive,
 						},
 						names.AttrTableName: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringLenBetween(1, 255),
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringLenBetween(1, 255),
+							DiffSuppressFunc: sdkv2.SuppressEquivalentStringCaseInsensitive,
 						},
 					},
 				},
@@ -280,3 +287,55 @@ func flattenTargetTable(apiObject *awstypes.DataQualityTargetTable) []any {
 
 	return []any{tfMap}
 }
+
+var dataQualityRulesetSectionRegexp = regexache.MustCompile(`(^|\s)(Rules|Analyzers)\s*=\s*\[`)
+
+// validateDataQualityRuleset performs basic DQDL syntax checks so that obvious errors are reported at plan time.
+// Full validation of the rules themselves is left to the Glue API.
+func validateDataQualityRuleset(v any, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !dataQualityRulesetSectionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must contain a Rules or Analyzers section, e.g. Rules = [ ... ]", k))
+	}
+
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var stack []rune
+	var quote rune
+	var escaped bool
+	for _, r := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			switch r {
+			case '\\':
+				escaped = true
+			case quote:
+				quote = 0
+			}
+		case r == '"':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			stack = append(stack, r)
+		case closing[r] != 0:
+			if len(stack) == 0 || stack[len(stack)-1] != closing[r] {
+				errors = append(errors, fmt.Errorf("%s has unbalanced brackets: unexpected %q", k, r))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		errors = append(errors, fmt.Errorf("%s has an unterminated string literal", k))
+	} else if len(stack) > 0 {
+		errors = append(errors, fmt.Errorf("%s has unbalanced brackets: unclosed %q", k, stack[len(stack)-1]))
+	}
+
+	return
+}
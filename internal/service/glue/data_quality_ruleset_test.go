@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -198,6 +199,68 @@ func TestAccGlueDataQualityRuleset_targetTableFull(t *testing.T) {
 	})
 }
 
+func TestAccGlueDataQualityRuleset_invalidRuleset(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataQualityRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataQualityRulesetConfig_basic(rName, "Completeness \"colA\" between 0.4 and 0.8"),
+				ExpectError: regexache.MustCompile(`must contain a Rules or Analyzers section`),
+			},
+			{
+				Config:      testAccDataQualityRulesetConfig_basic(rName, "Rules = [Completeness \"colA\" between 0.4 and 0.8"),
+				ExpectError: regexache.MustCompile(`unbalanced brackets: unclosed '\['`),
+			},
+			{
+				Config:      testAccDataQualityRulesetConfig_basic(rName, "Rules = [ColumnValues \"colA\" in [\"a\", \"b\")]"),
+				ExpectError: regexache.MustCompile(`unbalanced brackets: unexpected '\)'`),
+			},
+			{
+				Config:             testAccDataQualityRulesetConfig_basic(rName, "Rules = [ColumnValues \"colA\" in [\"a\\\"]\", \"b\"]]"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueDataQualityRuleset_targetTableMixedCase(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName3 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	ruleset := "Rules = [Completeness \"colA\" between 0.4 and 0.8]"
+	resourceName := "aws_glue_data_quality_ruleset.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataQualityRulesetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetConfig_targetTableMixedCase(rName, rName2, rName3, ruleset),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_table.#", "1"),
+				),
+			},
+			{
+				Config:   testAccDataQualityRulesetConfig_targetTableMixedCase(rName, rName2, rName3, ruleset),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccGlueDataQualityRuleset_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -396,6 +459,22 @@ resource "aws_glue_data_quality_ruleset" "test" {
 `, rName, ruleset))
 }
 
+func testAccDataQualityRulesetConfig_targetTableMixedCase(rName, rName2, rName3, ruleset string) string {
+	return acctest.ConfigCompose(
+		testAccDataQualityRulesetConfigTargetTableConfigBasic(rName2, rName3),
+		fmt.Sprintf(`
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = %[2]q
+
+  target_table {
+    database_name = upper(aws_glue_catalog_database.test.name)
+    table_name    = upper(aws_glue_catalog_table.test.name)
+  }
+}
+`, rName, ruleset))
+}
+
 func testAccDataQualityRulesetConfig_tags1(rName, ruleset, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_data_quality_ruleset" "test" {
@@ -71,6 +71,7 @@ var (
 	GeneratePassword                  = generatePassword
 	IsValidPolicyAWSPrincipal         = isValidPolicyAWSPrincipal // nosemgrep:ci.aws-in-var-name
 	ListGroupsForUserPages            = listGroupsForUserPages
+	OpenIDConnectProviderThumbprint   = openIDConnectProviderThumbprint
 	RoleNameSessionFromARN            = roleNameSessionFromARN
 	RolePolicyParseID                 = rolePolicyParseID
 	ServiceLinkedRoleParseResourceID  = serviceLinkedRoleParseResourceID
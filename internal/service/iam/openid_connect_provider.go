@@ -7,9 +7,17 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1" // nosemgrep: go/sast/internal/crypto/sha1 -- IAM OIDC provider thumbprints are SHA1 digests, must match AWS behavior
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -84,6 +92,15 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 
 	if v, ok := d.GetOk("thumbprint_list"); ok {
 		input.ThumbprintList = flex.ExpandStringValueList(v.([]any))
+	} else {
+		// IAM no longer requires a thumbprint for many IdPs, so failure to retrieve one isn't fatal.
+		thumbprint, err := retrieveOpenIDConnectProviderThumbprint(ctx, meta.(*conns.AWSClient), aws.ToString(input.Url))
+
+		if err != nil {
+			log.Printf("[WARN] Unable to retrieve IAM OIDC Provider (%s) thumbprint, IAM will determine it: %s", aws.ToString(input.Url), err)
+		} else {
+			input.ThumbprintList = []string{thumbprint}
+		}
 	}
 
 	output, err := conn.CreateOpenIDConnectProvider(ctx, input)
@@ -253,6 +270,101 @@ func findOpenIDConnectProvider(ctx context.Context, conn *iam.Client, input *iam
 	return output, nil
 }
 
+// retrieveOpenIDConnectProviderThumbprint returns the SHA-1 thumbprint of the top certificate in the certificate chain
+// presented by the host serving the IdP's JSON Web Key Set, as advertised in its OpenID Connect discovery document.
+// The provider's configured HTTP transport is used so that proxy, custom CA bundle and insecure settings are honored.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func retrieveOpenIDConnectProviderThumbprint(ctx context.Context, c *conns.AWSClient, providerURL string) (string, error) {
+	const (
+		timeout = 10 * time.Second
+	)
+	var transport http.RoundTripper
+	if v, ok := c.AwsConfig(ctx).HTTPClient.(*awshttp.BuildableClient); ok {
+		transport = v.GetTransport()
+	}
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+
+	return openIDConnectProviderThumbprint(ctx, client, providerURL)
+}
+
+func openIDConnectProviderThumbprint(ctx context.Context, client *http.Client, providerURL string) (string, error) {
+	jwksURI, err := findOpenIDConnectProviderJWKSURI(ctx, client, providerURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Request the JSON Web Key Set rather than dialing its host directly so that the TLS connection goes via any configured proxy.
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.TLS == nil {
+		return "", fmt.Errorf("JSON Web Key Set (%s) not served over TLS", jwksURI)
+	}
+
+	certificates := response.TLS.PeerCertificates
+
+	if len(certificates) == 0 {
+		return "", fmt.Errorf("no certificates presented by %s", request.URL.Host)
+	}
+
+	thumbprint := sha1.Sum(certificates[len(certificates)-1].Raw) // nosemgrep: go/sast/internal/crypto/sha1 -- IAM OIDC provider thumbprints are SHA1 digests
+
+	return hex.EncodeToString(thumbprint[:]), nil
+}
+
+// findOpenIDConnectProviderJWKSURI returns the jwks_uri value from the IdP's OpenID Connect discovery document.
+func findOpenIDConnectProviderJWKSURI(ctx context.Context, client *http.Client, providerURL string) (string, error) {
+	if !strings.Contains(providerURL, "://") {
+		providerURL = "https://" + providerURL
+	}
+	discoveryURL := strings.TrimSuffix(providerURL, "/") + "/.well-known/openid-configuration"
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("retrieving OpenID Connect discovery document (%s): unexpected HTTP status: %s", discoveryURL, response.Status)
+	}
+
+	var document struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&document); err != nil {
+		return "", fmt.Errorf("decoding OpenID Connect discovery document (%s): %w", discoveryURL, err)
+	}
+
+	if document.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID Connect discovery document (%s) has no jwks_uri", discoveryURL)
+	}
+
+	return document.JWKSURI, nil
+}
+
 func openIDConnectProviderTags(ctx context.Context, conn *iam.Client, identifier string, optFns ...func(*iam.Options)) ([]awstypes.Tag, error) {
 	input := iam.ListOpenIDConnectProviderTagsInput{
 		OpenIDConnectProviderArn: aws.String(identifier),
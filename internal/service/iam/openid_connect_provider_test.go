@@ -5,7 +5,12 @@ package iam_test
 
 import (
 	"context"
+	"crypto/sha1" // nosemgrep: go/sast/internal/crypto/sha1 -- IAM OIDC provider thumbprints are SHA1 digests, must match AWS behavior
+	"crypto/tls"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -17,6 +22,67 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	// The JSON Web Key Set is served from a different host, with a different certificate, than the discovery document.
+	keyPEM := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, keyPEM, "jwks.example.com")
+	certificate, err := tls.X509KeyPair([]byte(certificatePEM), []byte(keyPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwksServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"keys":[]}`)
+	}))
+	jwksServer.TLS = &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	jwksServer.StartTLS()
+	defer jwksServer.Close()
+
+	issuerServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"issuer":%q,"jwks_uri":%q}`, "https://"+r.Host, jwksServer.URL+"/keys")
+	}))
+	defer issuerServer.Close()
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true, // #nosec G402 -- self-signed test certificates
+				MinVersion:         tls.VersionTLS12,
+			},
+		},
+	}
+
+	got, err := tfiam.OpenIDConnectProviderThumbprint(ctx, client, issuerServer.URL)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	thumbprint := sha1.Sum(certificate.Certificate[0]) // nosemgrep: go/sast/internal/crypto/sha1 -- IAM OIDC provider thumbprints are SHA1 digests
+
+	if expected := hex.EncodeToString(thumbprint[:]); got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if _, err := tfiam.OpenIDConnectProviderThumbprint(ctx, client, issuerServer.URL+"/missing"); err == nil {
+		t.Error("expected error for missing discovery document")
+	}
+}
+
 func TestAccIAMOpenIDConnectProvider_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rString := acctest.RandString(t, 5)
@@ -43,6 +43,7 @@ func TestAccInspector2_serial(t *testing.T) {
 			"lambda":             testAccOrganizationConfiguration_lambda,
 			"lambdaCode":         testAccOrganizationConfiguration_lambdaCode,
 			"codeRepository":     testAccOrganizationConfiguration_codeRepository,
+			"ec2DeepInspection":  testAccOrganizationConfiguration_ec2DeepInspection,
 		},
 	}
 
@@ -15,9 +15,11 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -70,6 +72,16 @@ func resourceOrganizationConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"ec2_deep_inspection_package_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
 			"max_account_limit_reached": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -111,6 +123,17 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 	}
 	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
 
+	// Only read the EC2 deep inspection configuration if it's managed, as doing so requires additional permissions.
+	if v, ok := d.GetOk("ec2_deep_inspection_package_paths"); ok && len(v.([]any)) > 0 {
+		deepInspection, err := findEC2DeepInspectionConfiguration(ctx, conn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Inspector2 EC2 Deep Inspection Configuration (%s): %s", d.Id(), err)
+		}
+
+		d.Set("ec2_deep_inspection_package_paths", deepInspection.OrgPackagePaths)
+	}
+
 	return diags
 }
 
@@ -141,6 +164,18 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "waiting for Inspector2 Organization Configuration (%s) update: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("ec2_deep_inspection_package_paths"); ok && d.HasChange("ec2_deep_inspection_package_paths") {
+		input := &inspector2.UpdateOrgEc2DeepInspectionConfigurationInput{
+			OrgPackagePaths: flex.ExpandStringValueList(v.([]any)),
+		}
+
+		_, err := conn.UpdateOrgEc2DeepInspectionConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Inspector2 EC2 Deep Inspection Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -171,6 +206,16 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "waiting for Inspector2 Organization Configuration (%s) delete: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("ec2_deep_inspection_package_paths"); ok && len(v.([]any)) > 0 {
+		_, err := conn.UpdateOrgEc2DeepInspectionConfiguration(ctx, &inspector2.UpdateOrgEc2DeepInspectionConfigurationInput{
+			OrgPackagePaths: []string{},
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Inspector2 EC2 Deep Inspection Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -195,6 +240,21 @@ func findOrganizationConfiguration(ctx context.Context, conn *inspector2.Client)
 	return output, nil
 }
 
+func findEC2DeepInspectionConfiguration(ctx context.Context, conn *inspector2.Client) (*inspector2.GetEc2DeepInspectionConfigurationOutput, error) {
+	input := &inspector2.GetEc2DeepInspectionConfigurationInput{}
+	output, err := conn.GetEc2DeepInspectionConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
 func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.Client, target *awstypes.AutoEnable, timeout time.Duration) (*inspector2.DescribeOrganizationConfigurationOutput, error) { //nolint:unparam
 	var output *inspector2.DescribeOrganizationConfigurationOutput
 
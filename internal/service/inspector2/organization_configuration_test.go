@@ -185,6 +185,43 @@ func testAccOrganizationConfiguration_codeRepository(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_ec2DeepInspection(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_ec2DeepInspection(`"/opt/app/lib"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ec2_deep_inspection_package_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_deep_inspection_package_paths.0", "/opt/app/lib"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_ec2DeepInspection(`"/opt/app/lib", "/usr/local/app"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ec2_deep_inspection_package_paths.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_deep_inspection_package_paths.0", "/opt/app/lib"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_deep_inspection_package_paths.1", "/usr/local/app"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrganizationConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).Inspector2Client(ctx)
@@ -279,3 +316,22 @@ resource "aws_inspector2_organization_configuration" "test" {
 }
 `, ec2, ecr, codeRepository)
 }
+
+func testAccOrganizationConfigurationConfig_ec2DeepInspection(packagePaths string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2 = true
+    ecr = false
+  }
+
+  ec2_deep_inspection_package_paths = [%[1]s]
+
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+}
+`, packagePaths)
+}
@@ -7,9 +7,11 @@ package keyspaces
 var (
 	ResourceKeyspace = resourceKeyspace
 	ResourceTable    = resourceTable
+	ResourceType     = newTypeResource
 
 	FindKeyspaceByName    = findKeyspaceByName
 	FindTableByTwoPartKey = findTableByTwoPartKey
+	FindTypeByTwoPartKey  = findTypeByTwoPartKey
 
 	TableParseResourceID = tableParseResourceID
 )
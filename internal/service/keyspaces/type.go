@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keyspaces
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/keyspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/keyspaces/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_keyspaces_type", name="Type")
+func newTypeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &typeResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type typeResource struct {
+	framework.ResourceWithModel[typeResourceModel]
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *typeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"keyspace_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keyspace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 48),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_]*$`), "must begin with an alphanumeric character and contain only alphanumeric characters and underscores"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"field_definitions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[fieldDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *typeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data typeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KeyspacesClient(ctx)
+
+	keyspaceName, typeName := data.KeyspaceName.ValueString(), data.TypeName.ValueString()
+	var input keyspaces.CreateTypeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateType(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Keyspaces Type (%s/%s)", keyspaceName, typeName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := waitTypeCreated(ctx, conn, keyspaceName, typeName, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Keyspaces Type (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.KeyspaceARN = fwflex.StringToFramework(ctx, output.KeyspaceArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *typeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data typeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().KeyspacesClient(ctx)
+
+	output, err := findTypeByTwoPartKey(ctx, conn, data.KeyspaceName.ValueString(), data.TypeName.ValueString())
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Keyspaces Type (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *typeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data typeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KeyspacesClient(ctx)
+
+	keyspaceName, typeName := data.KeyspaceName.ValueString(), data.TypeName.ValueString()
+	input := keyspaces.DeleteTypeInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TypeName:     aws.String(typeName),
+	}
+	_, err := conn.DeleteType(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Keyspaces Type (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitTypeDeleted(ctx, conn, keyspaceName, typeName, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Keyspaces Type (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findTypeByTwoPartKey(ctx context.Context, conn *keyspaces.Client, keyspaceName, typeName string) (*keyspaces.GetTypeOutput, error) {
+	input := keyspaces.GetTypeInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TypeName:     aws.String(typeName),
+	}
+
+	output, err := conn.GetType(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+func statusType(conn *keyspaces.Client, keyspaceName, typeName string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findTypeByTwoPartKey(ctx, conn, keyspaceName, typeName)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTypeCreated(ctx context.Context, conn *keyspaces.Client, keyspaceName, typeName string, timeout time.Duration) (*keyspaces.GetTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.TypeStatusCreating),
+		Target:                    enum.Slice(awstypes.TypeStatusActive),
+		Refresh:                   statusType(conn, keyspaceName, typeName),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*keyspaces.GetTypeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTypeDeleted(ctx context.Context, conn *keyspaces.Client, keyspaceName, typeName string, timeout time.Duration) (*keyspaces.GetTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TypeStatusActive, awstypes.TypeStatusDeleting),
+		Target:  []string{},
+		Refresh: statusType(conn, keyspaceName, typeName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*keyspaces.GetTypeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type typeResourceModel struct {
+	framework.WithRegionModel
+	FieldDefinitions fwtypes.ListNestedObjectValueOf[fieldDefinitionModel] `tfsdk:"field_definitions"`
+	ID               types.String                                          `tfsdk:"id" autoflex:"-"`
+	KeyspaceARN      types.String                                          `tfsdk:"keyspace_arn"`
+	KeyspaceName     types.String                                          `tfsdk:"keyspace_name"`
+	Timeouts         timeouts.Value                                        `tfsdk:"timeouts"`
+	TypeName         types.String                                          `tfsdk:"type_name"`
+}
+
+const typeIDSeparator = "/"
+
+func (m *typeResourceModel) InitFromID() error {
+	parts := strings.Split(m.ID.ValueString(), typeIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("unexpected format for ID (%[1]s), expected KEYSPACE-NAME%[2]sTYPE-NAME", m.ID.ValueString(), typeIDSeparator)
+	}
+
+	m.KeyspaceName = types.StringValue(parts[0])
+	m.TypeName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *typeResourceModel) setID() {
+	m.ID = types.StringValue(strings.Join([]string{m.KeyspaceName.ValueString(), m.TypeName.ValueString()}, typeIDSeparator))
+}
+
+type fieldDefinitionModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
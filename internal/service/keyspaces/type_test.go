@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keyspaces_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfkeyspaces "github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKeyspacesType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := "tf_acc_test_" + acctest.RandString(t, 20)
+	rName2 := "tf_acc_test_" + acctest.RandString(t, 20)
+	resourceName := "aws_keyspaces_type.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeConfig_basic(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "field_definitions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "field_definitions.0.name", "street"),
+					resource.TestCheckResourceAttr(resourceName, "field_definitions.0.type", "text"),
+					resource.TestCheckResourceAttr(resourceName, "field_definitions.1.name", "zip"),
+					resource.TestCheckResourceAttr(resourceName, "field_definitions.1.type", "int"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, fmt.Sprintf("%s/%s", rName1, rName2)),
+					resource.TestCheckResourceAttrPair(resourceName, "keyspace_arn", "aws_keyspaces_keyspace.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "keyspace_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "type_name", rName2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := "tf_acc_test_" + acctest.RandString(t, 20)
+	rName2 := "tf_acc_test_" + acctest.RandString(t, 20)
+	resourceName := "aws_keyspaces_type.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTypeExists(ctx, t, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfkeyspaces.ResourceType, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTypeDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).KeyspacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_keyspaces_type" {
+				continue
+			}
+
+			_, err := tfkeyspaces.FindTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes["keyspace_name"], rs.Primary.Attributes["type_name"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Keyspaces Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTypeExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KeyspacesClient(ctx)
+
+		_, err := tfkeyspaces.FindTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes["keyspace_name"], rs.Primary.Attributes["type_name"])
+
+		return err
+	}
+}
+
+func testAccTypeConfig_basic(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_type" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  type_name     = %[2]q
+
+  field_definitions {
+    name = "street"
+    type = "text"
+  }
+
+  field_definitions {
+    name = "zip"
+    type = "int"
+  }
+}
+`, rName1, rName2)
+}
@@ -9,10 +9,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -576,6 +578,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkCodeSigningConfigARN,
 			updateComputedAttributesOnPublish,
 			customdiff.ForceNewIfChange("durable_config", func(_ context.Context, old, new, meta any) bool {
 				// Force new when durable_config is being added (from empty to non-empty) or removed (from non-empty to empty)
@@ -731,6 +734,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta an
 		return conn.CreateFunction(ctx, &input)
 	})
 
+	if errs.IsA[*awstypes.CodeVerificationFailedException](err) || errs.IsA[*awstypes.InvalidCodeSignatureException](err) {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): deployment package failed signature validation against code signing config (%s): %s", functionName, d.Get("code_signing_config_arn").(string), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
 	}
@@ -1028,6 +1035,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 		_, err := conn.UpdateFunctionCode(ctx, &input)
 
+		if errs.IsA[*awstypes.CodeVerificationFailedException](err) || errs.IsA[*awstypes.InvalidCodeSignatureException](err) {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: deployment package failed signature validation against code signing config (%s): %s", d.Id(), d.Get("code_signing_config_arn").(string), err)
+		}
+
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
 		}
@@ -1603,6 +1614,28 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// checkCodeSigningConfigARN verifies at plan time that code_signing_config_arn references
+// a Lambda code signing config rather than some other resource.
+func checkCodeSigningConfigARN(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	v, ok := d.GetOk("code_signing_config_arn")
+	if !ok {
+		return nil
+	}
+
+	value := v.(string)
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		return fmt.Errorf("code_signing_config_arn (%s) is an invalid ARN: %w", value, err)
+	}
+
+	if parsedARN.Service != names.LambdaEndpointID || !strings.HasPrefix(parsedARN.Resource, "code-signing-config:") {
+		return fmt.Errorf("code_signing_config_arn (%s) is not a Lambda code signing config ARN", value)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -248,6 +248,24 @@ func TestAccLambdaFunction_codeSigning(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_codeSigningInvalidARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_cscInvalidARN(rName),
+				ExpectError: regexache.MustCompile(`is not a Lambda code signing config ARN`),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_concurrency(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3349,6 +3367,21 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_cscInvalidARN(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename                = "test-fixtures/lambdatest.zip"
+  function_name           = %[1]q
+  role                    = aws_iam_role.iam_for_lambda.arn
+  handler                 = "exports.example"
+  runtime                 = "nodejs20.x"
+  code_signing_config_arn = "arn:aws:iam::123456789012:role/example"
+}
+`, rName))
+}
+
 func testAccFunctionConfig_cscUpdate(rName string) string {
 	return acctest.ConfigCompose(
 		testAccFunctionConfig_cscBase(rName),
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudwatch_log_account_subscription_filter", name="Account Subscription Filter")
+func newAccountSubscriptionFilterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &accountSubscriptionFilterResource{}
+
+	return r, nil
+}
+
+type accountSubscriptionFilterResource struct {
+	framework.ResourceWithModel[accountSubscriptionFilterResourceModel]
+}
+
+func (r *accountSubscriptionFilterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDestinationARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"distribution": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Distribution](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.DistributionByLogStream)),
+			},
+			"filter_pattern": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"selection_criteria": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(25600),
+				},
+			},
+		},
+	}
+}
+
+func (r *accountSubscriptionFilterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accountSubscriptionFilterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	name := data.Name.ValueString()
+	input, err := expandAccountSubscriptionFilterPutAccountPolicyInput(ctx, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Logs Account Subscription Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	_, err = conn.PutAccountPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudWatch Logs Account Subscription Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *accountSubscriptionFilterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data accountSubscriptionFilterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findAccountPolicyByTwoPartKey(ctx, conn, awstypes.PolicyTypeSubscriptionFilterPolicy, name)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Account Subscription Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	var document accountSubscriptionFilterPolicyDocument
+	if err := tfjson.DecodeFromString(aws.ToString(output.PolicyDocument), &document); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Account Subscription Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	data.DestinationARN = fwtypes.ARNValue(document.DestinationARN)
+	data.Distribution = fwtypes.StringEnumValue(awstypes.Distribution(document.Distribution))
+	data.FilterPattern = fwflex.StringValueToFrameworkLegacy(ctx, document.FilterPattern)
+	data.Name = fwflex.StringToFramework(ctx, output.PolicyName)
+	data.RoleARN = fwtypes.ARNNull()
+	if document.RoleARN != "" {
+		data.RoleARN = fwtypes.ARNValue(document.RoleARN)
+	}
+	data.SelectionCriteria = fwflex.StringToFramework(ctx, output.SelectionCriteria)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accountSubscriptionFilterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new accountSubscriptionFilterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	name := new.Name.ValueString()
+	input, err := expandAccountSubscriptionFilterPutAccountPolicyInput(ctx, &new)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Account Subscription Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	_, err = conn.PutAccountPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Logs Account Subscription Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *accountSubscriptionFilterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data accountSubscriptionFilterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LogsClient(ctx)
+
+	input := cloudwatchlogs.DeleteAccountPolicyInput{
+		PolicyName: fwflex.StringFromFramework(ctx, data.Name),
+		PolicyType: awstypes.PolicyTypeSubscriptionFilterPolicy,
+	}
+	_, err := conn.DeleteAccountPolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudWatch Logs Account Subscription Filter (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *accountSubscriptionFilterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+func expandAccountSubscriptionFilterPutAccountPolicyInput(ctx context.Context, data *accountSubscriptionFilterResourceModel) (*cloudwatchlogs.PutAccountPolicyInput, error) {
+	document := accountSubscriptionFilterPolicyDocument{
+		DestinationARN: data.DestinationARN.ValueString(),
+		Distribution:   data.Distribution.ValueString(),
+		FilterPattern:  data.FilterPattern.ValueString(),
+		RoleARN:        data.RoleARN.ValueString(),
+	}
+
+	policy, err := tfjson.EncodeToString(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := cloudwatchlogs.PutAccountPolicyInput{
+		PolicyDocument:    aws.String(policy),
+		PolicyName:        fwflex.StringFromFramework(ctx, data.Name),
+		PolicyType:        awstypes.PolicyTypeSubscriptionFilterPolicy,
+		Scope:             awstypes.ScopeAll,
+		SelectionCriteria: fwflex.StringFromFramework(ctx, data.SelectionCriteria),
+	}
+
+	return &input, nil
+}
+
+// accountSubscriptionFilterPolicyDocument is the JSON policy document of a SUBSCRIPTION_FILTER_POLICY account policy.
+type accountSubscriptionFilterPolicyDocument struct {
+	DestinationARN string `json:"DestinationArn"`
+	Distribution   string `json:"Distribution,omitempty"`
+	FilterPattern  string `json:"FilterPattern"`
+	RoleARN        string `json:"RoleArn,omitempty"`
+}
+
+type accountSubscriptionFilterResourceModel struct {
+	framework.WithRegionModel
+	DestinationARN    fwtypes.ARN                               `tfsdk:"destination_arn"`
+	Distribution      fwtypes.StringEnum[awstypes.Distribution] `tfsdk:"distribution"`
+	FilterPattern     types.String                              `tfsdk:"filter_pattern"`
+	Name              types.String                              `tfsdk:"name"`
+	RoleARN           fwtypes.ARN                               `tfsdk:"role_arn"`
+	SelectionCriteria types.String                              `tfsdk:"selection_criteria"`
+}
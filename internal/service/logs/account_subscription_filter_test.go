@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsAccountSubscriptionFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_subscription_filter.test"
+	var accountPolicy types.AccountPolicy
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionFilterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionFilterConfig_basic(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionFilterExists(ctx, t, resourceName, &accountPolicy),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDestinationARN, "aws_lambda_function.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "distribution", "ByLogStream"),
+					resource.TestCheckResourceAttr(resourceName, "filter_pattern", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrRoleARN),
+					resource.TestCheckNoResourceAttr(resourceName, "selection_criteria"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccAccountSubscriptionFilterConfig_basic(rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionFilterExists(ctx, t, resourceName, &accountPolicy),
+					resource.TestCheckResourceAttr(resourceName, "filter_pattern", "ERROR"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsAccountSubscriptionFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_subscription_filter.test"
+	var accountPolicy types.AccountPolicy
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionFilterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionFilterConfig_basic(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionFilterExists(ctx, t, resourceName, &accountPolicy),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tflogs.ResourceAccountSubscriptionFilter, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAccountSubscriptionFilter_selectionCriteria(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_account_subscription_filter.test"
+	var accountPolicy types.AccountPolicy
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionFilterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionFilterConfig_selectionCriteria(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionFilterExists(ctx, t, resourceName, &accountPolicy),
+					resource.TestCheckResourceAttr(resourceName, "distribution", "Random"),
+					resource.TestCheckResourceAttr(resourceName, "selection_criteria", fmt.Sprintf("LogGroupName NOT IN [\"%s\"]", rName)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSubscriptionFilterExists(ctx context.Context, t *testing.T, n string, v *types.AccountPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).LogsClient(ctx)
+
+		output, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, types.PolicyTypeSubscriptionFilterPolicy, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAccountSubscriptionFilterDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_account_subscription_filter" {
+				continue
+			}
+
+			_, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, types.PolicyTypeSubscriptionFilterPolicy, rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Account Subscription Filter still exists: %s", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountSubscriptionFilterConfig_basic(rName, filterPattern string) string {
+	return acctest.ConfigCompose(testAccAccountPolicyConfig_lambdaBase(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_account_subscription_filter" "test" {
+  name            = %[1]q
+  destination_arn = aws_lambda_function.test.arn
+  filter_pattern  = %[2]q
+}
+`, rName, filterPattern))
+}
+
+func testAccAccountSubscriptionFilterConfig_selectionCriteria(rName string) string {
+	return acctest.ConfigCompose(testAccAccountPolicyConfig_lambdaBase(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_account_subscription_filter" "test" {
+  name            = %[1]q
+  destination_arn = aws_lambda_function.test.arn
+  filter_pattern  = ""
+  distribution    = "Random"
+
+  selection_criteria = "LogGroupName NOT IN [\"%[1]s\"]"
+}
+`, rName))
+}
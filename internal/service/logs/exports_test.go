@@ -6,6 +6,7 @@ package logs
 // Exports for use in tests only.
 var (
 	ResourceAccountPolicy             = resourceAccountPolicy
+	ResourceAccountSubscriptionFilter = newAccountSubscriptionFilterResource
 	ResourceAnomalyDetector           = newAnomalyDetectorResource
 	ResourceDataProtectionPolicy      = resourceDataProtectionPolicy
 	ResourceDelivery                  = newDeliveryResource
@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_medialive_cloudwatch_alarm_template", name="CloudWatch Alarm Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/medialive;medialive.GetCloudWatchAlarmTemplateOutput")
+// @Testing(tagsTest=false)
+func newCloudWatchAlarmTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &cloudWatchAlarmTemplateResource{}, nil
+}
+
+type cloudWatchAlarmTemplateResource struct {
+	framework.ResourceWithModel[cloudWatchAlarmTemplateResourceModel]
+	framework.WithImportByID
+}
+
+func (r *cloudWatchAlarmTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"comparison_operator": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CloudWatchAlarmTemplateComparisonOperator](),
+				Required:   true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"datapoints_to_alarm": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"evaluation_periods": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrMetricName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"period": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(10, 86400),
+				},
+			},
+			"statistic": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CloudWatchAlarmTemplateStatistic](),
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CloudWatchAlarmTemplateTargetResourceType](),
+				Required:   true,
+			},
+			"threshold": schema.Float64Attribute{
+				Required: true,
+			},
+			"treat_missing_data": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CloudWatchAlarmTemplateTreatMissingData](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *cloudWatchAlarmTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cloudWatchAlarmTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	name := data.Name.ValueString()
+	var input medialive.CreateCloudWatchAlarmTemplateInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.RequestId = aws.String(create.UniqueId(ctx))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCloudWatchAlarmTemplate(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	// Set values for unknowns.
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *cloudWatchAlarmTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cloudWatchAlarmTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findCloudWatchAlarmTemplateByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The group can be specified by ID or name; on import default to the ID.
+	if data.GroupIdentifier.IsNull() {
+		data.GroupIdentifier = fwflex.StringToFramework(ctx, output.GroupId)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *cloudWatchAlarmTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old cloudWatchAlarmTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		id := fwflex.StringValueFromFramework(ctx, new.ID)
+		var input medialive.UpdateCloudWatchAlarmTemplateInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Identifier = aws.String(id)
+
+		output, err := conn.UpdateCloudWatchAlarmTemplate(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &new))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *cloudWatchAlarmTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cloudWatchAlarmTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := medialive.DeleteCloudWatchAlarmTemplateInput{
+		Identifier: aws.String(id),
+	}
+	_, err := conn.DeleteCloudWatchAlarmTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findCloudWatchAlarmTemplateByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.GetCloudWatchAlarmTemplateOutput, error) {
+	input := medialive.GetCloudWatchAlarmTemplateInput{
+		Identifier: aws.String(id),
+	}
+	output, err := conn.GetCloudWatchAlarmTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type cloudWatchAlarmTemplateResourceModel struct {
+	framework.WithRegionModel
+	ARN                fwtypes.ARN                                                            `tfsdk:"arn"`
+	ComparisonOperator fwtypes.StringEnum[awstypes.CloudWatchAlarmTemplateComparisonOperator] `tfsdk:"comparison_operator"`
+	CreatedAt          timetypes.RFC3339                                                      `tfsdk:"created_at"`
+	DatapointsToAlarm  types.Int32                                                            `tfsdk:"datapoints_to_alarm"`
+	Description        types.String                                                           `tfsdk:"description"`
+	EvaluationPeriods  types.Int32                                                            `tfsdk:"evaluation_periods"`
+	GroupID            types.String                                                           `tfsdk:"group_id"`
+	GroupIdentifier    types.String                                                           `tfsdk:"group_identifier"`
+	ID                 types.String                                                           `tfsdk:"id"`
+	MetricName         types.String                                                           `tfsdk:"metric_name"`
+	Name               types.String                                                           `tfsdk:"name"`
+	Period             types.Int32                                                            `tfsdk:"period"`
+	Statistic          fwtypes.StringEnum[awstypes.CloudWatchAlarmTemplateStatistic]          `tfsdk:"statistic"`
+	Tags               tftags.Map                                                             `tfsdk:"tags"`
+	TagsAll            tftags.Map                                                             `tfsdk:"tags_all"`
+	TargetResourceType fwtypes.StringEnum[awstypes.CloudWatchAlarmTemplateTargetResourceType] `tfsdk:"target_resource_type"`
+	Threshold          types.Float64                                                          `tfsdk:"threshold"`
+	TreatMissingData   fwtypes.StringEnum[awstypes.CloudWatchAlarmTemplateTreatMissingData]   `tfsdk:"treat_missing_data"`
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveCloudWatchAlarmTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	groupIdentifier := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_CLOUDWATCH_ALARM_TEMPLATE_GROUP_IDENTIFIER")
+
+	var v medialive.GetCloudWatchAlarmTemplateOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_medialive_cloudwatch_alarm_template.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudWatchAlarmTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudWatchAlarmTemplateConfig_basic(rName, groupIdentifier, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateExists(ctx, t, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "medialive", regexache.MustCompile(`cloudwatch-alarm-template:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "comparison_operator", string(awstypes.CloudWatchAlarmTemplateComparisonOperatorGreaterThanOrEqualToThreshold)),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_periods", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "group_identifier", groupIdentifier),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMetricName, "ActiveAlerts"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "period", "300"),
+					resource.TestCheckResourceAttr(resourceName, "statistic", string(awstypes.CloudWatchAlarmTemplateStatisticMaximum)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_type", string(awstypes.CloudWatchAlarmTemplateTargetResourceTypeMedialiveChannel)),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "5"),
+					resource.TestCheckResourceAttr(resourceName, "treat_missing_data", string(awstypes.CloudWatchAlarmTemplateTreatMissingDataNotBreaching)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group_identifier"},
+			},
+			{
+				Config: testAccCloudWatchAlarmTemplateConfig_basic(rName, groupIdentifier, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveCloudWatchAlarmTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	groupIdentifier := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_CLOUDWATCH_ALARM_TEMPLATE_GROUP_IDENTIFIER")
+
+	var v medialive.GetCloudWatchAlarmTemplateOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_medialive_cloudwatch_alarm_template.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudWatchAlarmTemplateDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudWatchAlarmTemplateConfig_basic(rName, groupIdentifier, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmedialive.ResourceCloudWatchAlarmTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchAlarmTemplateDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_cloudwatch_alarm_template" {
+				continue
+			}
+
+			_, err := tfmedialive.FindCloudWatchAlarmTemplateByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaLive CloudWatch Alarm Template %s still exists", rs.Primary.Attributes[names.AttrID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudWatchAlarmTemplateExists(ctx context.Context, t *testing.T, n string, v *medialive.GetCloudWatchAlarmTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MediaLiveClient(ctx)
+
+		output, err := tfmedialive.FindCloudWatchAlarmTemplateByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCloudWatchAlarmTemplateConfig_basic(rName, groupIdentifier string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_cloudwatch_alarm_template" "test" {
+  name                 = %[1]q
+  group_identifier     = %[2]q
+  metric_name          = "ActiveAlerts"
+  comparison_operator  = "GreaterThanOrEqualToThreshold"
+  evaluation_periods   = 1
+  period               = 300
+  statistic            = "Maximum"
+  target_resource_type = "MEDIALIVE_CHANNEL"
+  threshold            = %[3]d
+  treat_missing_data   = "notBreaching"
+}
+`, rName, groupIdentifier, threshold)
+}
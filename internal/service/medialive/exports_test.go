@@ -5,16 +5,20 @@ package medialive
 
 // Exports for use in tests only.
 var (
-	ResourceChannel            = resourceChannel
-	ResourceInput              = resourceInput
-	ResourceInputSecurityGroup = resourceInputSecurityGroup
-	ResourceMultiplex          = resourceMultiplex
-	ResourceMultiplexProgram   = newMultiplexProgramResource
+	ResourceChannel                 = resourceChannel
+	ResourceCloudWatchAlarmTemplate = newCloudWatchAlarmTemplateResource
+	ResourceInput                   = resourceInput
+	ResourceInputSecurityGroup      = resourceInputSecurityGroup
+	ResourceMultiplex               = resourceMultiplex
+	ResourceMultiplexProgram        = newMultiplexProgramResource
+	ResourceSignalMap               = newSignalMapResource
 
-	FindChannelByID            = findChannelByID
-	FindInputByID              = findInputByID
-	FindInputSecurityGroupByID = findInputSecurityGroupByID
-	FindMultiplexByID          = findMultiplexByID
-	FindMultiplexProgramByID   = findMultiplexProgramByID
-	ParseMultiplexProgramID    = parseMultiplexProgramID
+	FindChannelByID                 = findChannelByID
+	FindCloudWatchAlarmTemplateByID = findCloudWatchAlarmTemplateByID
+	FindInputByID                   = findInputByID
+	FindInputSecurityGroupByID      = findInputSecurityGroupByID
+	FindMultiplexByID               = findMultiplexByID
+	FindMultiplexProgramByID        = findMultiplexProgramByID
+	FindSignalMapByID               = findSignalMapByID
+	ParseMultiplexProgramID         = parseMultiplexProgramID
 )
@@ -34,12 +34,30 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newCloudWatchAlarmTemplateResource,
+			TypeName: "aws_medialive_cloudwatch_alarm_template",
+			Name:     "CloudWatch Alarm Template",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newMultiplexProgramResource,
 			TypeName: "aws_medialive_multiplex_program",
 			Name:     "Multiplex Program",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newSignalMapResource,
+			TypeName: "aws_medialive_signal_map",
+			Name:     "Signal Map",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -0,0 +1,467 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_medialive_signal_map", name="Signal Map")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/medialive;medialive.GetSignalMapOutput")
+// @Testing(tagsTest=false)
+func newSignalMapResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &signalMapResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type signalMapResource struct {
+	framework.ResourceWithModel[signalMapResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *signalMapResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cloudwatch_alarm_template_group_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cloudwatch_alarm_template_group_identifiers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"discovery_entry_point_arn": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			"eventbridge_rule_template_group_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"eventbridge_rule_template_group_identifiers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SignalMapStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *signalMapResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data signalMapResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	name := data.Name.ValueString()
+	input := medialive.CreateSignalMapInput{
+		CloudWatchAlarmTemplateGroupIdentifiers: fwflex.ExpandFrameworkStringValueSet(ctx, data.CloudWatchAlarmTemplateGroupIdentifiers),
+		Description:                             fwflex.StringFromFramework(ctx, data.Description),
+		DiscoveryEntryPointArn:                  fwflex.StringFromFramework(ctx, data.DiscoveryEntryPointARN),
+		EventBridgeRuleTemplateGroupIdentifiers: fwflex.ExpandFrameworkStringValueSet(ctx, data.EventBridgeRuleTemplateGroupIdentifiers),
+		Name:                                    aws.String(name),
+		RequestId:                               aws.String(create.UniqueId(ctx)),
+		Tags:                                    getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateSignalMap(ctx, &input)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	id := aws.ToString(output.Id)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	signalMap, err := waitSignalMapCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	// Set values for unknowns.
+	flattenSignalMap(ctx, signalMap, &data)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *signalMapResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data signalMapResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findSignalMapByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		smerr.AddOne(ctx, &response.Diagnostics, fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	flattenSignalMap(ctx, output, &data)
+
+	setTagsOut(ctx, output.Tags)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *signalMapResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old signalMapResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+	if signalMapHasChanges(&new, &old) {
+		input := medialive.StartUpdateSignalMapInput{
+			CloudWatchAlarmTemplateGroupIdentifiers: fwflex.ExpandFrameworkStringValueSet(ctx, new.CloudWatchAlarmTemplateGroupIdentifiers),
+			Description:                             fwflex.StringFromFramework(ctx, new.Description),
+			DiscoveryEntryPointArn:                  fwflex.StringFromFramework(ctx, new.DiscoveryEntryPointARN),
+			EventBridgeRuleTemplateGroupIdentifiers: fwflex.ExpandFrameworkStringValueSet(ctx, new.EventBridgeRuleTemplateGroupIdentifiers),
+			Identifier:                              aws.String(id),
+			Name:                                    fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		// Removing all template groups requires an explicit empty list.
+		if input.CloudWatchAlarmTemplateGroupIdentifiers == nil {
+			input.CloudWatchAlarmTemplateGroupIdentifiers = []string{}
+		}
+		if input.EventBridgeRuleTemplateGroupIdentifiers == nil {
+			input.EventBridgeRuleTemplateGroupIdentifiers = []string{}
+		}
+
+		_, err := conn.StartUpdateSignalMap(ctx, &input)
+
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		if _, err := waitSignalMapUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+	}
+
+	output, err := findSignalMapByID(ctx, conn, id)
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	flattenSignalMap(ctx, output, &new)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *signalMapResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data signalMapResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaLiveClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := medialive.DeleteSignalMapInput{
+		Identifier: aws.String(id),
+	}
+	_, err := conn.DeleteSignalMap(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	if _, err := waitSignalMapDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func (r *signalMapResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state signalMapResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !signalMapHasChanges(&plan, &state) {
+		return
+	}
+
+	// Updating the signal map changes its status and may change the resolved template group IDs.
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.SetAttribute(ctx, path.Root(names.AttrStatus), fwtypes.StringEnumUnknown[awstypes.SignalMapStatus]()))
+
+	if !plan.CloudWatchAlarmTemplateGroupIdentifiers.Equal(state.CloudWatchAlarmTemplateGroupIdentifiers) {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.SetAttribute(ctx, path.Root("cloudwatch_alarm_template_group_ids"), fwtypes.NewSetValueOfUnknown[types.String](ctx)))
+	}
+	if !plan.EventBridgeRuleTemplateGroupIdentifiers.Equal(state.EventBridgeRuleTemplateGroupIdentifiers) {
+		smerr.AddEnrich(ctx, &response.Diagnostics, response.Plan.SetAttribute(ctx, path.Root("eventbridge_rule_template_group_ids"), fwtypes.NewSetValueOfUnknown[types.String](ctx)))
+	}
+}
+
+// signalMapHasChanges returns whether any attributes updated by StartUpdateSignalMap have changed.
+func signalMapHasChanges(new, old *signalMapResourceModel) bool {
+	return !new.CloudWatchAlarmTemplateGroupIdentifiers.Equal(old.CloudWatchAlarmTemplateGroupIdentifiers) ||
+		!new.Description.Equal(old.Description) ||
+		!new.DiscoveryEntryPointARN.Equal(old.DiscoveryEntryPointARN) ||
+		!new.EventBridgeRuleTemplateGroupIdentifiers.Equal(old.EventBridgeRuleTemplateGroupIdentifiers) ||
+		!new.Name.Equal(old.Name)
+}
+
+func flattenSignalMap(ctx context.Context, output *medialive.GetSignalMapOutput, data *signalMapResourceModel) {
+	data.ARN = fwflex.StringToFrameworkARN(ctx, output.Arn)
+	data.CloudWatchAlarmTemplateGroupIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, output.CloudWatchAlarmTemplateGroupIds)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.DiscoveryEntryPointARN = fwflex.StringToFramework(ctx, output.DiscoveryEntryPointArn)
+	data.EventBridgeRuleTemplateGroupIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, output.EventBridgeRuleTemplateGroupIds)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	// The template groups can be specified by ID or name; on import default to the IDs.
+	if data.CloudWatchAlarmTemplateGroupIdentifiers.IsNull() && len(output.CloudWatchAlarmTemplateGroupIds) > 0 {
+		data.CloudWatchAlarmTemplateGroupIdentifiers = data.CloudWatchAlarmTemplateGroupIDs
+	}
+	if data.EventBridgeRuleTemplateGroupIdentifiers.IsNull() && len(output.EventBridgeRuleTemplateGroupIds) > 0 {
+		data.EventBridgeRuleTemplateGroupIdentifiers = data.EventBridgeRuleTemplateGroupIDs
+	}
+}
+
+func findSignalMapByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.GetSignalMapOutput, error) {
+	input := medialive.GetSignalMapInput{
+		Identifier: aws.String(id),
+	}
+	output, err := conn.GetSignalMap(ctx, &input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func statusSignalMap(conn *medialive.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findSignalMapByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitSignalMapCreated(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.GetSignalMapOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SignalMapStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.SignalMapStatusCreateComplete, awstypes.SignalMapStatusReady),
+		Refresh: statusSignalMap(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.GetSignalMapOutput); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitSignalMapUpdated(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.GetSignalMapOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SignalMapStatusUpdateInProgress),
+		Target:  enum.Slice(awstypes.SignalMapStatusUpdateComplete, awstypes.SignalMapStatusReady),
+		Refresh: statusSignalMap(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.GetSignalMapOutput); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+func waitSignalMapDeleted(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.GetSignalMapOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SignalMapStatusCreateComplete, awstypes.SignalMapStatusNotReady, awstypes.SignalMapStatusReady, awstypes.SignalMapStatusUpdateComplete),
+		Target:  []string{},
+		Refresh: statusSignalMap(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.GetSignalMapOutput); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, smarterr.NewError(err)
+	}
+
+	return nil, smarterr.NewError(err)
+}
+
+type signalMapResourceModel struct {
+	framework.WithRegionModel
+	ARN                                     fwtypes.ARN                                  `tfsdk:"arn"`
+	CloudWatchAlarmTemplateGroupIDs         fwtypes.SetOfString                          `tfsdk:"cloudwatch_alarm_template_group_ids"`
+	CloudWatchAlarmTemplateGroupIdentifiers fwtypes.SetOfString                          `tfsdk:"cloudwatch_alarm_template_group_identifiers"`
+	CreatedAt                               timetypes.RFC3339                            `tfsdk:"created_at"`
+	Description                             types.String                                 `tfsdk:"description"`
+	DiscoveryEntryPointARN                  types.String                                 `tfsdk:"discovery_entry_point_arn"`
+	EventBridgeRuleTemplateGroupIDs         fwtypes.SetOfString                          `tfsdk:"eventbridge_rule_template_group_ids"`
+	EventBridgeRuleTemplateGroupIdentifiers fwtypes.SetOfString                          `tfsdk:"eventbridge_rule_template_group_identifiers"`
+	ID                                      types.String                                 `tfsdk:"id"`
+	Name                                    types.String                                 `tfsdk:"name"`
+	Status                                  fwtypes.StringEnum[awstypes.SignalMapStatus] `tfsdk:"status"`
+	Tags                                    tftags.Map                                   `tfsdk:"tags"`
+	TagsAll                                 tftags.Map                                   `tfsdk:"tags_all"`
+	Timeouts                                timeouts.Value                               `tfsdk:"timeouts"`
+}
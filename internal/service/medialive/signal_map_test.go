@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveSignalMap_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	discoveryEntryPointARN := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_SIGNAL_MAP_DISCOVERY_ENTRY_POINT_ARN")
+
+	var v medialive.GetSignalMapOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_medialive_signal_map.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalMapDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalMapConfig_basic(rName, discoveryEntryPointARN, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "medialive", regexache.MustCompile(`signal-map:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "discovery_entry_point_arn", discoveryEntryPointARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalMapConfig_basic(rName, discoveryEntryPointARN, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.SignalMapStatusUpdateComplete)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveSignalMap_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	discoveryEntryPointARN := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_SIGNAL_MAP_DISCOVERY_ENTRY_POINT_ARN")
+
+	var v medialive.GetSignalMapOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_medialive_signal_map.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalMapDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalMapConfig_basic(rName, discoveryEntryPointARN, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfmedialive.ResourceSignalMap, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveSignalMap_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	discoveryEntryPointARN := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_SIGNAL_MAP_DISCOVERY_ENTRY_POINT_ARN")
+
+	var v medialive.GetSignalMapOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_medialive_signal_map.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalMapDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalMapConfig_tags1(rName, discoveryEntryPointARN, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalMapConfig_tags2(rName, discoveryEntryPointARN, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				Config: testAccSignalMapConfig_tags1(rName, discoveryEntryPointARN, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveSignalMap_cloudWatchAlarmTemplateGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	discoveryEntryPointARN := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_SIGNAL_MAP_DISCOVERY_ENTRY_POINT_ARN")
+	groupIdentifier := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_CLOUDWATCH_ALARM_TEMPLATE_GROUP_IDENTIFIER")
+
+	var v medialive.GetSignalMapOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_medialive_signal_map.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalMapDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalMapConfig_basic(rName, discoveryEntryPointARN, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_template_group_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccSignalMapConfig_cloudWatchAlarmTemplateGroup(rName, discoveryEntryPointARN, groupIdentifier),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("cloudwatch_alarm_template_group_ids")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New(names.AttrStatus)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_template_group_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_template_group_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccSignalMapConfig_basic(rName, discoveryEntryPointARN, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalMapExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_template_group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSignalMapDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_signal_map" {
+				continue
+			}
+
+			_, err := tfmedialive.FindSignalMapByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaLive Signal Map %s still exists", rs.Primary.Attributes[names.AttrID])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSignalMapExists(ctx context.Context, t *testing.T, n string, v *medialive.GetSignalMapOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MediaLiveClient(ctx)
+
+		output, err := tfmedialive.FindSignalMapByID(ctx, conn, rs.Primary.Attributes[names.AttrID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSignalMapConfig_basic(rName, discoveryEntryPointARN, description string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_signal_map" "test" {
+  name                      = %[1]q
+  discovery_entry_point_arn = %[2]q
+  description               = %[3]q
+}
+`, rName, discoveryEntryPointARN, description)
+}
+
+func testAccSignalMapConfig_cloudWatchAlarmTemplateGroup(rName, discoveryEntryPointARN, groupIdentifier string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_signal_map" "test" {
+  name                      = %[1]q
+  discovery_entry_point_arn = %[2]q
+  description               = "test"
+
+  cloudwatch_alarm_template_group_identifiers = [%[3]q]
+}
+`, rName, discoveryEntryPointARN, groupIdentifier)
+}
+
+func testAccSignalMapConfig_tags1(rName, discoveryEntryPointARN, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_signal_map" "test" {
+  name                      = %[1]q
+  discovery_entry_point_arn = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, discoveryEntryPointARN, tagKey1, tagValue1)
+}
+
+func testAccSignalMapConfig_tags2(rName, discoveryEntryPointARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_signal_map" "test" {
+  name                      = %[1]q
+  discovery_entry_point_arn = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, discoveryEntryPointARN, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -84,6 +84,23 @@ func resourceSiteToSiteVPNAttachment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"proposed_segment_change": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attachment_policy_rule_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"segment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTags: tftags.TagsSchemaComputed(),
+					},
+				},
+			},
 			"routing_policy_label": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -134,10 +151,16 @@ func resourceSiteToSiteVPNAttachmentCreate(ctx context.Context, d *schema.Resour
 
 	d.SetId(aws.ToString(output.SiteToSiteVpnAttachment.Attachment.AttachmentId))
 
-	if _, err := waitSiteToSiteVPNAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	vpnAttachment, err := waitSiteToSiteVPNAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Site To Site VPN Attachment (%s) create: %s", d.Id(), err)
 	}
 
+	if vpnAttachment.Attachment.State == awstypes.AttachmentStatePendingAttachmentAcceptance {
+		diags = sdkdiag.AppendWarningf(diags, "Network Manager Site To Site VPN Attachment (%s) is pending acceptance by the core network owner. Use the aws_networkmanager_attachment_accepter resource to accept it.", d.Id())
+	}
+
 	return append(diags, resourceSiteToSiteVPNAttachmentRead(ctx, d, meta)...)
 }
 
@@ -166,6 +189,9 @@ func resourceSiteToSiteVPNAttachmentRead(ctx context.Context, d *schema.Resource
 	d.Set("core_network_id", coreNetworkID)
 	d.Set("edge_location", attachment.EdgeLocation)
 	d.Set(names.AttrOwnerAccountID, attachment.OwnerAccountId)
+	if err := d.Set("proposed_segment_change", flattenProposedSegmentChange(ctx, attachment.ProposedSegmentChange)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting proposed_segment_change: %s", err)
+	}
 	d.Set(names.AttrResourceARN, attachment.ResourceArn)
 	if routingPolicyLabel, err := findAttachmentRoutingPolicyAssociationLabelByTwoPartKey(ctx, conn, coreNetworkID, d.Id()); err != nil && !retry.NotFound(err) {
 		return sdkdiag.AppendErrorf(diags, "reading Network Manager Site To Site VPN Attachment (%s) routing policy label: %s", d.Id(), err)
@@ -331,3 +357,17 @@ func waitSiteToSiteVPNAttachmentAvailable(ctx context.Context, conn *networkmana
 
 	return nil, err
 }
+
+func flattenProposedSegmentChange(ctx context.Context, apiObject *awstypes.ProposedSegmentChange) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"attachment_policy_rule_number": aws.ToInt32(apiObject.AttachmentPolicyRuleNumber),
+		"segment_name":                  aws.ToString(apiObject.SegmentName),
+		names.AttrTags:                  keyValueTags(ctx, apiObject.Tags).IgnoreAWS().Map(),
+	}
+
+	return []any{tfMap}
+}
@@ -0,0 +1,15 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+// Exports for use in tests only.
+var (
+	ResourceConnector             = newConnectorResource
+	ResourceDirectoryRegistration = newDirectoryRegistrationResource
+	ResourceTemplate              = newTemplateResource
+
+	FindConnectorByARN             = findConnectorByARN
+	FindDirectoryRegistrationByARN = findDirectoryRegistrationByARN
+	FindTemplateByARN              = findTemplateByARN
+)
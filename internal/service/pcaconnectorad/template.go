@@ -0,0 +1,698 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_template", name="Template")
+// @Tags(identifierAttribute="arn")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithModel[templateResourceModel]
+	framework.WithImportByID
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	optionalBoolDefaultFalse := func() schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		}
+	}
+	validityPeriodBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"period": schema.Int64Attribute{
+						Required: true,
+					},
+					"period_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV2Model](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"superseded_templates": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"renewal_period":  validityPeriodBlock(),
+												"validity_period": validityPeriodBlock(),
+											},
+										},
+									},
+									"enrollment_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[enrollmentFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"enable_key_reuse_on_nt_token_keyset_storage_full": optionalBoolDefaultFalse(),
+												"include_symmetric_algorithms":                     optionalBoolDefaultFalse(),
+												"no_security_extension":                            optionalBoolDefaultFalse(),
+												"remove_invalid_certificate_from_personal_store":   optionalBoolDefaultFalse(),
+												"user_interaction_required":                        optionalBoolDefaultFalse(),
+											},
+										},
+									},
+									"extensions": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"application_policies": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"critical": optionalBoolDefaultFalse(),
+														},
+														Blocks: map[string]schema.Block{
+															"policy": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeBetween(1, 100),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"policy_object_identifier": schema.StringAttribute{
+																			Optional: true,
+																			Validators: []validator.String{
+																				stringvalidator.ExactlyOneOf(
+																					path.MatchRelative().AtParent().AtName("policy_object_identifier"),
+																					path.MatchRelative().AtParent().AtName("policy_type"),
+																				),
+																			},
+																		},
+																		"policy_type": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+																			Optional:   true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"key_usage": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"critical": optionalBoolDefaultFalse(),
+														},
+														Blocks: map[string]schema.Block{
+															"usage_flags": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageFlagsModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"data_encipherment": optionalBoolDefaultFalse(),
+																		"digital_signature": optionalBoolDefaultFalse(),
+																		"key_agreement":     optionalBoolDefaultFalse(),
+																		"key_encipherment":  optionalBoolDefaultFalse(),
+																		"non_repudiation":   optionalBoolDefaultFalse(),
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"general_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[generalFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"auto_enrollment": optionalBoolDefaultFalse(),
+												"machine_type":    optionalBoolDefaultFalse(),
+											},
+										},
+									},
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"crypto_providers": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"key_spec": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+													Required:   true,
+												},
+												"minimal_key_length": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV2](),
+													Required:   true,
+												},
+												"exportable_key":                 optionalBoolDefaultFalse(),
+												"strong_key_protection_required": optionalBoolDefaultFalse(),
+											},
+										},
+									},
+									"subject_name_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[subjectNameFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"require_common_name":        optionalBoolDefaultFalse(),
+												"require_directory_path":     optionalBoolDefaultFalse(),
+												"require_dns_as_cn":          optionalBoolDefaultFalse(),
+												"require_email":              optionalBoolDefaultFalse(),
+												"san_require_directory_guid": optionalBoolDefaultFalse(),
+												"san_require_dns":            optionalBoolDefaultFalse(),
+												"san_require_domain_dns":     optionalBoolDefaultFalse(),
+												"san_require_email":          optionalBoolDefaultFalse(),
+												"san_require_spn":            optionalBoolDefaultFalse(),
+												"san_require_upn":            optionalBoolDefaultFalse(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	name := data.Name.ValueString()
+	var input pcaconnectorad.CreateTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(create.UniqueId(ctx))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCA Connector for AD Template (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.TemplateArn)
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ID = data.ARN
+
+	template, err := findTemplateByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, template.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFrameworkInt64(ctx, template.PolicySchema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findTemplateByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// reenroll_all_certificate_holders is an update-time flag that isn't returned by the API.
+	if data.ReenrollAllCertificateHolders.IsNull() {
+		data.ReenrollAllCertificateHolders = types.BoolValue(false)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		arn := new.ID.ValueString()
+		var input pcaconnectorad.UpdateTemplateInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.TemplateArn = aws.String(arn)
+
+		_, err := conn.UpdateTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCA Connector for AD Template (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := data.ID.ValueString()
+	input := pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	_, err := conn.DeleteTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCA Connector for AD Template (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	output, err := conn.GetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	if status := output.Template.Status; status == awstypes.TemplateStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output.Template, nil
+}
+
+type templateResourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	ID                            types.String                                             `tfsdk:"id" autoflex:"-"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int64                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          tftags.Map                                               `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                               `tfsdk:"tags_all"`
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+}
+
+var (
+	_ fwflex.Expander  = templateDefinitionModel{}
+	_ fwflex.Flattener = &templateDefinitionModel{}
+)
+
+func (m templateDefinitionModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var v awstypes.TemplateDefinition
+
+	switch {
+	case !m.TemplateV2.IsNull():
+		data, d := m.TemplateV2.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.TemplateDefinitionMemberTemplateV2
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		v = &apiObject
+	}
+
+	return v, diags
+}
+
+func (m *templateDefinitionModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch t := v.(type) {
+	case awstypes.TemplateDefinitionMemberTemplateV2:
+		var data templateV2Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsV2Model]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsV2Model]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsV2Model]         `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsV2Model]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListOfString                                         `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsV2Model struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsV2Model struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+var (
+	_ fwflex.Expander  = applicationPolicyModel{}
+	_ fwflex.Flattener = &applicationPolicyModel{}
+)
+
+func (m applicationPolicyModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var v awstypes.ApplicationPolicy
+
+	switch {
+	case !m.PolicyObjectIdentifier.IsNull():
+		v = &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+			Value: m.PolicyObjectIdentifier.ValueString(),
+		}
+	case !m.PolicyType.IsNull():
+		v = &awstypes.ApplicationPolicyMemberPolicyType{
+			Value: m.PolicyType.ValueEnum(),
+		}
+	}
+
+	return v, diags
+}
+
+func (m *applicationPolicyModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.PolicyObjectIdentifier = types.StringNull()
+	m.PolicyType = fwtypes.StringEnumNull[awstypes.ApplicationPolicyType]()
+
+	switch t := v.(type) {
+	case awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+		m.PolicyObjectIdentifier = types.StringValue(t.Value)
+	case awstypes.ApplicationPolicyMemberPolicyType:
+		m.PolicyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsV2Model struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.ListOfString                 `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int64                          `tfsdk:"minimal_key_length"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type subjectNameFlagsV2Model struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SANRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SANRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SANRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SANRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SANRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SANRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
@@ -0,0 +1,774 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/resiliencehub/types;awstypes;awstypes.App")
+// @Testing(importStateIdAttribute="arn")
+func newAppResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &appResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// draftAppVersion is the application version that template and resource mapping changes are applied to.
+	draftAppVersion = "draft"
+)
+
+type appResource struct {
+	framework.ResourceWithModel[appResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *appResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_template_body": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"assessment_schedule": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AppAssessmentScheduleType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.AppAssessmentScheduleTypeDisabled)),
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 60),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]+$`), "Must start with an alphanumeric character and contain alphanumeric characters, underscores, or hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resiliency_policy_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"event_subscription": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[eventSubscriptionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"event_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EventType](),
+							Required:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						names.AttrSNSTopicARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+			"permission_model": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[permissionModelModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cross_account_role_arns": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfARNType,
+							ElementType: fwtypes.ARNType,
+							Optional:    true,
+						},
+						"invoker_role_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PermissionModelType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"resource_mapping": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceMappingModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"app_registry_app_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"eks_source_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"logical_stack_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"mapping_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ResourceMappingType](),
+							Required:   true,
+						},
+						"resource_group_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"resource_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"terraform_source_name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"physical_resource_id": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[physicalResourceIDModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAWSAccountID: schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"aws_region": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrIdentifier: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.PhysicalIdentifierType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *appResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	name := data.Name.ValueString()
+	var input resiliencehub.CreateAppInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(create.UniqueId(ctx))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApp(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Resilience Hub App (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.App.AppArn)
+	data.AppARN = fwflex.StringValueToFramework(ctx, arn)
+
+	if _, err := waitAppCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if !data.AppTemplateBody.IsUnknown() && !data.AppTemplateBody.IsNull() {
+		if err := putDraftAppVersionTemplate(ctx, conn, arn, data.AppTemplateBody.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("putting Resilience Hub App (%s) template", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !data.ResourceMappings.IsNull() {
+		var mappings []awstypes.ResourceMapping
+		response.Diagnostics.Append(fwflex.Expand(ctx, data.ResourceMappings, &mappings)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := addDraftAppVersionResourceMappings(ctx, conn, arn, mappings); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("adding Resilience Hub App (%s) resource mappings", arn), err.Error())
+
+			return
+		}
+	}
+
+	if (!data.AppTemplateBody.IsUnknown() && !data.AppTemplateBody.IsNull()) || !data.ResourceMappings.IsNull() {
+		if err := publishAppVersion(ctx, conn, arn); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Resilience Hub App (%s) version", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(r.readDraftAppVersion(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *appResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := data.AppARN.ValueString()
+	output, err := findAppByARN(ctx, conn, arn)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.readDraftAppVersion(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *appResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old appResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := new.AppARN.ValueString()
+
+	if !new.AssessmentSchedule.Equal(old.AssessmentSchedule) ||
+		!new.Description.Equal(old.Description) ||
+		!new.EventSubscriptions.Equal(old.EventSubscriptions) ||
+		!new.PermissionModel.Equal(old.PermissionModel) ||
+		!new.PolicyARN.Equal(old.PolicyARN) {
+		var input resiliencehub.UpdateAppInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if new.Description.IsNull() && !old.Description.IsNull() {
+			input.Description = aws.String("")
+		}
+
+		if new.EventSubscriptions.IsNull() && !old.EventSubscriptions.IsNull() {
+			input.EventSubscriptions = []awstypes.EventSubscription{}
+		}
+
+		if new.PolicyARN.IsNull() && !old.PolicyARN.IsNull() {
+			input.ClearResiliencyPolicyArn = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateApp(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resilience Hub App (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitAppUpdated(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	var publish bool
+
+	if !new.AppTemplateBody.IsUnknown() && !new.AppTemplateBody.IsNull() && !new.AppTemplateBody.Equal(old.AppTemplateBody) {
+		publish = true
+
+		if err := putDraftAppVersionTemplate(ctx, conn, arn, new.AppTemplateBody.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Resilience Hub App (%s) template", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.ResourceMappings.Equal(old.ResourceMappings) {
+		publish = true
+
+		var oldMappings, newMappings []awstypes.ResourceMapping
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.ResourceMappings, &oldMappings)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.ResourceMappings, &newMappings)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := removeDraftAppVersionResourceMappings(ctx, conn, arn, oldMappings); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing Resilience Hub App (%s) resource mappings", arn), err.Error())
+
+			return
+		}
+
+		if err := addDraftAppVersionResourceMappings(ctx, conn, arn, newMappings); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding Resilience Hub App (%s) resource mappings", arn), err.Error())
+
+			return
+		}
+	}
+
+	if publish {
+		if err := publishAppVersion(ctx, conn, arn); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Resilience Hub App (%s) version", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(r.readDraftAppVersion(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *appResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data appResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := data.AppARN.ValueString()
+	input := resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(arn),
+		ClientToken: aws.String(create.UniqueId(ctx)),
+	}
+	_, err := conn.DeleteApp(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resilience Hub App (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *appResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+// readDraftAppVersion sets the template body and resource mappings of the application's draft version.
+func (r *appResource) readDraftAppVersion(ctx context.Context, conn *resiliencehub.Client, data *appResourceModel) (diags diag.Diagnostics) {
+	arn := data.AppARN.ValueString()
+
+	templateBody, err := findDraftAppVersionTemplateBodyByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Resilience Hub App (%s) template", arn), err.Error())
+
+		return diags
+	}
+
+	data.AppTemplateBody = jsontypes.NewNormalizedValue(templateBody)
+
+	mappings, err := findDraftAppVersionResourceMappingsByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Resilience Hub App (%s) resource mappings", arn), err.Error())
+
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, mappings, &data.ResourceMappings)...)
+
+	return diags
+}
+
+func putDraftAppVersionTemplate(ctx context.Context, conn *resiliencehub.Client, arn, templateBody string) error {
+	input := resiliencehub.PutDraftAppVersionTemplateInput{
+		AppArn:          aws.String(arn),
+		AppTemplateBody: aws.String(templateBody),
+	}
+	_, err := conn.PutDraftAppVersionTemplate(ctx, &input)
+
+	return err
+}
+
+// publishAppVersion publishes the application's draft version so that assessments use the current template and resource mappings.
+func publishAppVersion(ctx context.Context, conn *resiliencehub.Client, arn string) error {
+	input := resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(arn),
+	}
+	_, err := conn.PublishAppVersion(ctx, &input)
+
+	return err
+}
+
+func addDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.Client, arn string, mappings []awstypes.ResourceMapping) error {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	input := resiliencehub.AddDraftAppVersionResourceMappingsInput{
+		AppArn:           aws.String(arn),
+		ResourceMappings: mappings,
+	}
+	_, err := conn.AddDraftAppVersionResourceMappings(ctx, &input)
+
+	return err
+}
+
+func removeDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.Client, arn string, mappings []awstypes.ResourceMapping) error {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	input := resiliencehub.RemoveDraftAppVersionResourceMappingsInput{
+		AppArn: aws.String(arn),
+	}
+	for _, v := range mappings {
+		switch v.MappingType {
+		case awstypes.ResourceMappingTypeAppRegistryApp:
+			input.AppRegistryAppNames = append(input.AppRegistryAppNames, aws.ToString(v.AppRegistryAppName))
+		case awstypes.ResourceMappingTypeCfnStack:
+			input.LogicalStackNames = append(input.LogicalStackNames, aws.ToString(v.LogicalStackName))
+		case awstypes.ResourceMappingTypeEks:
+			input.EksSourceNames = append(input.EksSourceNames, aws.ToString(v.EksSourceName))
+		case awstypes.ResourceMappingTypeResource:
+			input.ResourceNames = append(input.ResourceNames, aws.ToString(v.ResourceName))
+		case awstypes.ResourceMappingTypeResourceGroup:
+			input.ResourceGroupNames = append(input.ResourceGroupNames, aws.ToString(v.ResourceGroupName))
+		case awstypes.ResourceMappingTypeTerraform:
+			input.TerraformSourceNames = append(input.TerraformSourceNames, aws.ToString(v.TerraformSourceName))
+		}
+	}
+	_, err := conn.RemoveDraftAppVersionResourceMappings(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findAppByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.App, error) {
+	input := resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+	output, err := conn.DescribeApp(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.App, nil
+}
+
+func findDraftAppVersionTemplateBodyByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (string, error) {
+	input := resiliencehub.DescribeAppVersionTemplateInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(draftAppVersion),
+	}
+	output, err := conn.DescribeAppVersionTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError()
+	}
+
+	return aws.ToString(output.AppTemplateBody), nil
+}
+
+func findDraftAppVersionResourceMappingsByARN(ctx context.Context, conn *resiliencehub.Client, arn string) ([]awstypes.ResourceMapping, error) {
+	input := resiliencehub.ListAppVersionResourceMappingsInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(draftAppVersion),
+	}
+	var output []awstypes.ResourceMapping
+
+	pages := resiliencehub.NewListAppVersionResourceMappingsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceMappings...)
+	}
+
+	return output, nil
+}
+
+func statusApp(conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findAppByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppCreated(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.AppStatusTypeActive),
+		Refresh:                   statusApp(conn, arn),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppUpdated(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.AppStatusTypeActive),
+		Refresh:                   statusApp(conn, arn),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppStatusTypeActive, awstypes.AppStatusTypeDeleting),
+		Target:  []string{},
+		Refresh: statusApp(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type appResourceModel struct {
+	framework.WithRegionModel
+	AppARN             types.String                                            `tfsdk:"arn"`
+	AppTemplateBody    jsontypes.Normalized                                    `tfsdk:"app_template_body" autoflex:"-"`
+	AssessmentSchedule fwtypes.StringEnum[awstypes.AppAssessmentScheduleType]  `tfsdk:"assessment_schedule"`
+	Description        types.String                                            `tfsdk:"description"`
+	EventSubscriptions fwtypes.ListNestedObjectValueOf[eventSubscriptionModel] `tfsdk:"event_subscription"`
+	Name               types.String                                            `tfsdk:"name"`
+	PermissionModel    fwtypes.ListNestedObjectValueOf[permissionModelModel]   `tfsdk:"permission_model"`
+	PolicyARN          fwtypes.ARN                                             `tfsdk:"resiliency_policy_arn"`
+	ResourceMappings   fwtypes.ListNestedObjectValueOf[resourceMappingModel]   `tfsdk:"resource_mapping" autoflex:"-"`
+	Tags               tftags.Map                                              `tfsdk:"tags"`
+	TagsAll            tftags.Map                                              `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+type eventSubscriptionModel struct {
+	EventType   fwtypes.StringEnum[awstypes.EventType] `tfsdk:"event_type"`
+	Name        types.String                           `tfsdk:"name"`
+	SNSTopicARN fwtypes.ARN                            `tfsdk:"sns_topic_arn"`
+}
+
+type permissionModelModel struct {
+	CrossAccountRoleARNs fwtypes.ListOfARN                                `tfsdk:"cross_account_role_arns"`
+	InvokerRoleName      types.String                                     `tfsdk:"invoker_role_name"`
+	Type                 fwtypes.StringEnum[awstypes.PermissionModelType] `tfsdk:"type"`
+}
+
+type resourceMappingModel struct {
+	AppRegistryAppName  types.String                                             `tfsdk:"app_registry_app_name"`
+	EKSSourceName       types.String                                             `tfsdk:"eks_source_name"`
+	LogicalStackName    types.String                                             `tfsdk:"logical_stack_name"`
+	MappingType         fwtypes.StringEnum[awstypes.ResourceMappingType]         `tfsdk:"mapping_type"`
+	PhysicalResourceID  fwtypes.ListNestedObjectValueOf[physicalResourceIDModel] `tfsdk:"physical_resource_id"`
+	ResourceGroupName   types.String                                             `tfsdk:"resource_group_name"`
+	ResourceName        types.String                                             `tfsdk:"resource_name"`
+	TerraformSourceName types.String                                             `tfsdk:"terraform_source_name"`
+}
+
+type physicalResourceIDModel struct {
+	AWSAccountID types.String                                        `tfsdk:"aws_account_id"`
+	AWSRegion    types.String                                        `tfsdk:"aws_region"`
+	Identifier   types.String                                        `tfsdk:"identifier"`
+	Type         fwtypes.StringEnum[awstypes.PhysicalIdentifierType] `tfsdk:"type"`
+}
@@ -0,0 +1,384 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResilienceHubApp_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app awstypes.App
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, endpoints.AwsUsGovPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, t, resourceName, &app),
+					resource.TestCheckResourceAttrSet(resourceName, "app_template_body"),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, names.ResilienceHubServiceID, regexache.MustCompile(`app/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, "resiliency_policy_arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app awstypes.App
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, endpoints.AwsUsGovPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, t, resourceName, &app),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfresiliencehub.ResourceApp, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app awstypes.App
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, endpoints.AwsUsGovPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_full(rName, "description 1", "Disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, t, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.0.event_type", "DriftDetected"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_subscription.0.sns_topic_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "permission_model.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permission_model.0.type", "RoleBased"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_model.0.invoker_role_name", "aws_iam_role.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "resiliency_policy_arn", "aws_resiliencehub_resiliency_policy.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccAppConfig_full(rName, "description 2", "Daily"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, t, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, t, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.#", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "resiliency_policy_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_resourceMapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app awstypes.App
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, endpoints.AwsUsGovPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_resourceMapping(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, t, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.0.mapping_type", "CfnStack"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.0.physical_resource_id.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_mapping.0.physical_resource_id.0.identifier", "aws_cloudformation_stack.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.0.physical_resource_id.0.type", "Arn"),
+					testAccCheckAppVersionPublished(ctx, t, resourceName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckAppDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ResilienceHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resiliencehub_app" {
+				continue
+			}
+
+			_, err := tfresiliencehub.FindAppByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resilience Hub App %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAppExists(ctx context.Context, t *testing.T, n string, v *awstypes.App) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ResilienceHubClient(ctx)
+
+		output, err := tfresiliencehub.FindAppByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAppVersionPublished(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ResilienceHubClient(ctx)
+
+		input := resiliencehub.ListAppVersionsInput{
+			AppArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+		}
+		output, err := conn.ListAppVersions(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.AppVersions {
+			if aws.ToString(v.AppVersion) != "draft" {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Resilience Hub App %s has no published version", rs.Primary.Attributes[names.AttrARN])
+	}
+}
+
+func testAccAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppConfig_full(rName, description, assessmentSchedule string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = "NotApplicable"
+
+  policy {
+    az {
+      rpo = "24h"
+      rto = "24h"
+    }
+    hardware {
+      rpo = "24h"
+      rto = "24h"
+    }
+    software {
+      rpo = "24h"
+      rto = "24h"
+    }
+  }
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "resiliencehub.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AWSResilienceHubAsssessmentExecutionPolicy"
+}
+
+resource "aws_resiliencehub_app" "test" {
+  name                  = %[1]q
+  description           = %[2]q
+  assessment_schedule   = %[3]q
+  resiliency_policy_arn = aws_resiliencehub_resiliency_policy.test.arn
+
+  event_subscription {
+    name          = %[1]q
+    event_type    = "DriftDetected"
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  permission_model {
+    type              = "RoleBased"
+    invoker_role_name = aws_iam_role.test.name
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, description, assessmentSchedule)
+}
+
+func testAccAppConfig_resourceMapping(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    Resources = {
+      Topic = {
+        Type = "AWS::SNS::Topic"
+      }
+    }
+  })
+}
+
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+
+  app_template_body = jsonencode({
+    resources         = []
+    appComponents     = []
+    excludedResources = {}
+    version           = 2
+  })
+
+  resource_mapping {
+    mapping_type       = "CfnStack"
+    logical_stack_name = aws_cloudformation_stack.test.name
+
+    physical_resource_id {
+      identifier = aws_cloudformation_stack.test.id
+      type       = "Arn"
+    }
+  }
+}
+`, rName)
+}
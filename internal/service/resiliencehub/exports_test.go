@@ -5,5 +5,8 @@ package resiliencehub
 
 // Exports for use in tests only.
 var (
+	ResourceApp              = newAppResource
 	ResourceResiliencyPolicy = newResiliencyPolicyResource
+
+	FindAppByARN = findAppByARN
 )
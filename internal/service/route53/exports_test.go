@@ -35,8 +35,13 @@ var (
 	FindTrafficPolicyInstanceByID               = findTrafficPolicyInstanceByID
 	FindVPCAssociationAuthorizationByTwoPartKey = findVPCAssociationAuthorizationByTwoPartKey
 	FindZoneAssociationByThreePartKey           = findZoneAssociationByThreePartKey
+	KeySigningKeyStatusActionNeeded             = keySigningKeyStatusActionNeeded
 	KeySigningKeyStatusActive                   = keySigningKeyStatusActive
+	KeySigningKeyStatusDeleting                 = keySigningKeyStatusDeleting
 	KeySigningKeyStatusInactive                 = keySigningKeyStatusInactive
+	KeySigningKeyStatusInternalFailure          = keySigningKeyStatusInternalFailure
+	KeySigningKeysStatus                        = keySigningKeysStatus
+	KeySigningKeysStatusPending                 = keySigningKeysStatusPending
 	ServeSignatureNotSigning                    = serveSignatureNotSigning
 	ServeSignatureSigning                       = serveSignatureSigning
 	WaitChangeInsync                            = waitChangeInsync
@@ -34,6 +34,11 @@ const (
 	serveSignatureSigning         = "SIGNING"
 )
 
+const (
+	// keySigningKeysStatusPending is the aggregate status of key-signing keys that are transitioning.
+	keySigningKeysStatusPending = "PENDING"
+)
+
 // @SDKResource("aws_route53_hosted_zone_dnssec", name="Hosted Zone DNSSEC")
 func resourceHostedZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
@@ -198,6 +203,14 @@ func hostedZoneDNSSECDisable(ctx context.Context, conn *route53.Client, hostedZo
 }
 
 func hostedZoneDNSSECEnable(ctx context.Context, conn *route53.Client, hostedZoneID string, waitTimeout time.Duration) error {
+	// Enabling DNSSEC signing fails unless the hosted zone's key-signing keys have been activated.
+	const (
+		keySigningKeysActiveTimeout = 5 * time.Minute
+	)
+	if _, err := waitHostedZoneKeySigningKeysActive(ctx, conn, hostedZoneID, keySigningKeysActiveTimeout); err != nil {
+		return fmt.Errorf("waiting for Route 53 Hosted Zone DNSSEC (%s) key-signing keys activate: %w", hostedZoneID, err)
+	}
+
 	input := &route53.EnableHostedZoneDNSSECInput{
 		HostedZoneId: aws.String(hostedZoneID),
 	}
@@ -271,7 +284,10 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Client
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.DNSSECStatus); ok {
-		if serveSignature := aws.ToString(output.ServeSignature); serveSignature == serveSignatureInternalFailure {
+		switch serveSignature := aws.ToString(output.ServeSignature); serveSignature {
+		case serveSignatureActionNeeded:
+			retry.SetLastError(err, errors.New(actionNeededMessage(aws.ToString(output.StatusMessage))))
+		case serveSignatureInternalFailure:
 			retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
 		}
 
@@ -280,3 +296,93 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Client
 
 	return nil, err
 }
+
+// statusHostedZoneKeySigningKeys returns the aggregate status of a hosted zone's key-signing keys.
+func statusHostedZoneKeySigningKeys(conn *route53.Client, hostedZoneID string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findHostedZoneDNSSECByZoneID(ctx, conn, hostedZoneID)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := keySigningKeysStatus(output.KeySigningKeys)
+
+		// Waiting can't succeed if no key-signing key is active or becoming active.
+		if status == "" {
+			return nil, "", errors.New("hosted zone has no active key-signing keys")
+		}
+
+		return output.KeySigningKeys, status, nil
+	}
+}
+
+// keySigningKeysStatus returns ACTIVE once at least one key-signing key is active.
+// A key that requires action or has failed takes precedence so that waiting fails fast.
+// A key in any other, transitional, status is pending.
+// An empty status is returned if there are no keys or all keys are inactive (e.g. during key rotation) or being deleted.
+func keySigningKeysStatus(keys []awstypes.KeySigningKey) string {
+	var status string
+
+	for _, v := range keys {
+		switch v := aws.ToString(v.Status); v {
+		case keySigningKeyStatusActionNeeded, keySigningKeyStatusInternalFailure:
+			return v
+		case keySigningKeyStatusActive:
+			status = v
+		case keySigningKeyStatusDeleting, keySigningKeyStatusInactive:
+		default:
+			if status == "" {
+				status = keySigningKeysStatusPending
+			}
+		}
+	}
+
+	return status
+}
+
+func waitHostedZoneKeySigningKeysActive(ctx context.Context, conn *route53.Client, hostedZoneID string, timeout time.Duration) ([]awstypes.KeySigningKey, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{keySigningKeysStatusPending},
+		Target:     []string{keySigningKeyStatusActive},
+		Refresh:    statusHostedZoneKeySigningKeys(conn, hostedZoneID),
+		MinTimeout: 5 * time.Second,
+		Timeout:    timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.KeySigningKey); ok {
+		var keyErrs []error
+
+		for _, v := range output {
+			switch status := aws.ToString(v.Status); status {
+			case keySigningKeyStatusActionNeeded:
+				keyErrs = append(keyErrs, fmt.Errorf("key-signing key (%s): %s", aws.ToString(v.Name), actionNeededMessage(aws.ToString(v.StatusMessage))))
+			case keySigningKeyStatusInternalFailure:
+				keyErrs = append(keyErrs, fmt.Errorf("key-signing key (%s): %s", aws.ToString(v.Name), aws.ToString(v.StatusMessage)))
+			}
+		}
+
+		retry.SetLastError(err, errors.Join(keyErrs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// actionNeededMessage adds remediation guidance to an ACTION_NEEDED status message.
+func actionNeededMessage(statusMessage string) string {
+	const guidance = "verify that the customer managed KMS key is enabled and usable by Route 53, and that the DS record in the parent zone matches the key-signing key"
+
+	if statusMessage == "" {
+		return guidance
+	}
+
+	return fmt.Sprintf("%s; %s", statusMessage, guidance)
+}
@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -113,6 +116,108 @@ func TestAccRoute53HostedZoneDNSSEC_signingStatus(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HostedZoneDNSSEC_inactiveKeySigningKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedZoneDNSSECDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedZoneDNSSECConfig_inactiveKeySigningKey(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccHostedZoneDNSSECExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
+					resource.TestCheckResourceAttr("aws_route53_key_signing_key.inactive", names.AttrStatus, tfroute53.KeySigningKeyStatusInactive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53HostedZoneDNSSEC_noKeySigningKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedZoneDNSSECDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHostedZoneDNSSECConfig_noKeySigningKey(domainName),
+				ExpectError: regexache.MustCompile(`hosted zone has no active key-signing keys`),
+			},
+		},
+	})
+}
+
+func TestKeySigningKeysStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statuses []string
+		expected string
+	}{
+		"no keys": {},
+		"inactive": {
+			statuses: []string{tfroute53.KeySigningKeyStatusInactive},
+		},
+		"inactive and deleting": {
+			statuses: []string{tfroute53.KeySigningKeyStatusInactive, tfroute53.KeySigningKeyStatusDeleting},
+		},
+		"transitional": {
+			statuses: []string{tfroute53.KeySigningKeyStatusInactive, "ACTIVATING"},
+			expected: tfroute53.KeySigningKeysStatusPending,
+		},
+		"active": {
+			statuses: []string{tfroute53.KeySigningKeyStatusActive},
+			expected: tfroute53.KeySigningKeyStatusActive,
+		},
+		"active and inactive": {
+			statuses: []string{tfroute53.KeySigningKeyStatusInactive, tfroute53.KeySigningKeyStatusActive},
+			expected: tfroute53.KeySigningKeyStatusActive,
+		},
+		"active and transitional": {
+			statuses: []string{"ACTIVATING", tfroute53.KeySigningKeyStatusActive},
+			expected: tfroute53.KeySigningKeyStatusActive,
+		},
+		"active and deleting": {
+			statuses: []string{tfroute53.KeySigningKeyStatusDeleting, tfroute53.KeySigningKeyStatusActive},
+			expected: tfroute53.KeySigningKeyStatusActive,
+		},
+		"action needed": {
+			statuses: []string{tfroute53.KeySigningKeyStatusActive, tfroute53.KeySigningKeyStatusActionNeeded},
+			expected: tfroute53.KeySigningKeyStatusActionNeeded,
+		},
+		"internal failure": {
+			statuses: []string{tfroute53.KeySigningKeyStatusInactive, tfroute53.KeySigningKeyStatusInternalFailure},
+			expected: tfroute53.KeySigningKeyStatusInternalFailure,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var keys []awstypes.KeySigningKey
+			for _, v := range testCase.statuses {
+				keys = append(keys, awstypes.KeySigningKey{Status: aws.String(v)})
+			}
+
+			if got, expected := tfroute53.KeySigningKeysStatus(keys), testCase.expected; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func testAccCheckHostedZoneDNSSECDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).Route53Client(ctx)
@@ -219,3 +324,40 @@ resource "aws_route53_hosted_zone_dnssec" "test" {
 }
 `, signingStatus))
 }
+
+func testAccHostedZoneDNSSECConfig_inactiveKeySigningKey(rName, domainName string) string {
+	return acctest.ConfigCompose(testAccHostedZoneDNSSECConfig_base(rName, domainName), fmt.Sprintf(`
+resource "aws_kms_key" "inactive" {
+  customer_master_key_spec = "ECC_NIST_P256"
+  deletion_window_in_days  = 7
+  enable_key_rotation      = true
+  key_usage                = "SIGN_VERIFY"
+  policy                   = aws_kms_key.test.policy
+}
+
+resource "aws_route53_key_signing_key" "inactive" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.inactive.arn
+  name                       = "%[1]s-inactive"
+  status                     = "INACTIVE"
+}
+
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  depends_on = [aws_route53_key_signing_key.inactive]
+
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+}
+`, rName))
+}
+
+func testAccHostedZoneDNSSECConfig_noKeySigningKey(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  hosted_zone_id = aws_route53_zone.test.id
+}
+`, domainName)
+}
@@ -32,10 +32,11 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 			"nonDefaultControlPane": testAccRoutingControl_nonDefaultControlPanel,
 		},
 		"SafetyRule": {
-			"assertionRule":      testAccSafetyRule_assertionRule,
-			"gatingRule":         testAccSafetyRule_gatingRule,
-			acctest.CtDisappears: testAccSafetyRule_disappears,
-			"tags":               testAccSafetyRule_tags,
+			"assertionRule":            testAccSafetyRule_assertionRule,
+			"gatingRule":               testAccSafetyRule_gatingRule,
+			acctest.CtDisappears:       testAccSafetyRule_disappears,
+			"tags":                     testAccSafetyRule_tags,
+			"thresholdExceedsControls": testAccSafetyRule_thresholdExceedsControls,
 		},
 	}
 
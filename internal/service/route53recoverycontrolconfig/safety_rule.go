@@ -7,6 +7,7 @@ package route53recoverycontrolconfig
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -121,6 +122,8 @@ func resourceSafetyRule() *schema.Resource {
 				Required: true,
 			},
 		},
+
+		CustomizeDiff: checkSafetyRuleThreshold,
 	}
 }
 
@@ -164,6 +167,8 @@ func resourceSafetyRuleRead(ctx context.Context, d *schema.ResourceData, meta an
 		if err := d.Set("asserted_controls", flex.FlattenStringValueList(result.AssertedControls)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting asserted_controls: %s", err)
 		}
+		d.Set("gating_controls", nil)
+		d.Set("target_controls", nil)
 
 		if result.RuleConfig != nil {
 			d.Set("rule_config", []any{flattenRuleConfig(result.RuleConfig)})
@@ -177,6 +182,7 @@ func resourceSafetyRuleRead(ctx context.Context, d *schema.ResourceData, meta an
 	if output.GatingRule != nil {
 		result := output.GatingRule
 		d.Set(names.AttrARN, result.SafetyRuleArn)
+		d.Set("asserted_controls", nil)
 		d.Set("control_panel_arn", result.ControlPanelArn)
 		d.Set(names.AttrName, result.Name)
 		d.Set(names.AttrStatus, result.Status)
@@ -352,6 +358,10 @@ func updateAssertionRule(ctx context.Context, d *schema.ResourceData, meta any)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Assertion Rule: %s", err)
 		}
+
+		if _, err := waitSafetyRuleUpdated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Route53 Recovery Control Config Assertion Rule (%s) to be Deployed: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, sdkdiag.WrapDiagsf(resourceSafetyRuleRead(ctx, d, meta), "updating Route53 Recovery Control Config Assertion Rule")...)
@@ -383,10 +393,42 @@ func updateGatingRule(ctx context.Context, d *schema.ResourceData, meta any) dia
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Gating Rule: %s", err)
 		}
+
+		if _, err := waitSafetyRuleUpdated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Route53 Recovery Control Config Gating Rule (%s) to be Deployed: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, sdkdiag.WrapDiagsf(resourceSafetyRuleRead(ctx, d, meta), "updating Route53 Recovery Control Config Gating Rule")...)
 }
+
+// checkSafetyRuleThreshold ensures that rule_config.threshold can be satisfied by the configured controls.
+func checkSafetyRuleThreshold(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("rule_config") {
+		return nil
+	}
+
+	v, ok := d.GetOk("rule_config")
+	if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+		return nil
+	}
+	threshold := v.([]any)[0].(map[string]any)["threshold"].(int)
+
+	for _, k := range []string{"asserted_controls", "gating_controls"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+
+		if v, ok := d.GetOk(k); ok {
+			if n := len(v.([]any)); threshold > n {
+				return fmt.Errorf("rule_config.threshold (%d) must not exceed the number of %s (%d)", threshold, k, n)
+			}
+		}
+	}
+
+	return nil
+}
+
 func findSafetyRuleByARN(ctx context.Context, conn *r53rcc.Client, arn string) (*r53rcc.DescribeSafetyRuleOutput, error) {
 	input := &r53rcc.DescribeSafetyRuleInput{
 		SafetyRuleArn: aws.String(arn),
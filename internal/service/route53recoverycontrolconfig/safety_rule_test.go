@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -52,6 +53,27 @@ func testAccSafetyRule_assertionRule(t *testing.T) {
 	})
 }
 
+func testAccSafetyRule_thresholdExceedsControls(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Route53RecoveryControlConfigEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53RecoveryControlConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSafetyRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSafetyRuleConfig_thresholdExceedsControls(rName),
+				ExpectError: regexache.MustCompile(`rule_config.threshold \(3\) must not exceed the number of asserted_controls \(2\)`),
+			},
+		},
+	})
+}
+
 func testAccSafetyRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -252,6 +274,30 @@ resource "aws_route53recoverycontrolconfig_safety_rule" "test" {
 `, rName)
 }
 
+func testAccSafetyRuleConfig_thresholdExceedsControls(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_safety_rule" "test" {
+  name              = %[1]q
+  control_panel_arn = "arn:${data.aws_partition.current.partition}:route53-recovery-control::${data.aws_caller_identity.current.account_id}:controlpanel/abcdef0123456789"
+  wait_period_ms    = 5000
+  asserted_controls = [
+    "arn:${data.aws_partition.current.partition}:route53-recovery-control::${data.aws_caller_identity.current.account_id}:controlpanel/abcdef0123456789/routingcontrol/0123456789abcdef",
+    "arn:${data.aws_partition.current.partition}:route53-recovery-control::${data.aws_caller_identity.current.account_id}:controlpanel/abcdef0123456789/routingcontrol/fedcba9876543210",
+  ]
+
+  rule_config {
+    inverted  = false
+    threshold = 3
+    type      = "ATLEAST"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
+
 func testAccSafetyRuleConfig_routingControlGating(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_route53recoverycontrolconfig_cluster" "test" {
@@ -165,6 +165,24 @@ func waitSafetyRuleCreated(ctx context.Context, conn *r53rcc.Client, safetyRuleA
 	return nil, err
 }
 
+func waitSafetyRuleUpdated(ctx context.Context, conn *r53rcc.Client, safetyRuleArn string) (*r53rcc.DescribeSafetyRuleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusPending),
+		Target:     enum.Slice(awstypes.StatusDeployed),
+		Refresh:    statusSafetyRule(conn, safetyRuleArn),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitSafetyRuleDeleted(ctx context.Context, conn *r53rcc.Client, safetyRuleArn string) (*r53rcc.DescribeSafetyRuleOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StatusPendingDeletion),
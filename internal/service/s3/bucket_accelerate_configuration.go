@@ -7,7 +7,9 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -57,6 +59,8 @@ func resourceBucketAccelerateConfiguration() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.BucketAccelerateStatus](),
 			},
 		},
+
+		CustomizeDiff: resourceBucketAccelerateConfigurationCustomizeDiff,
 	}
 }
 
@@ -131,7 +135,12 @@ func resourceBucketAccelerateConfigurationRead(ctx context.Context, d *schema.Re
 
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
-	d.Set(names.AttrStatus, output.Status)
+	// A bucket on which Transfer Acceleration has never been configured returns no status.
+	if status := output.Status; status == "" {
+		d.Set(names.AttrStatus, types.BucketAccelerateStatusSuspended)
+	} else {
+		d.Set(names.AttrStatus, status)
+	}
 
 	return diags
 }
@@ -207,6 +216,15 @@ func resourceBucketAccelerateConfigurationDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceBucketAccelerateConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// Transfer Acceleration isn't supported for buckets with periods in their names.
+	if bucket, status := d.Get(names.AttrBucket).(string), d.Get(names.AttrStatus).(string); status == string(types.BucketAccelerateStatusEnabled) && strings.Contains(bucket, ".") {
+		return fmt.Errorf("S3 Transfer Acceleration cannot be enabled on bucket (%s): bucket names containing periods are not supported", bucket)
+	}
+
+	return nil
+}
+
 func findBucketAccelerateConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketAccelerateConfigurationOutput, error) {
 	input := s3.GetBucketAccelerateConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -107,6 +107,24 @@ func TestAccS3BucketAccelerateConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketAccelerateConfiguration_bucketNameWithPeriods(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketName := fmt.Sprintf("%s.example", acctest.RandomWithPrefix(t, acctest.ResourcePrefix))
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketAccelerateConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketAccelerateConfigurationConfig_basic(bucketName, string(types.BucketAccelerateStatusEnabled)),
+				ExpectError: regexache.MustCompile(`bucket names containing periods are not supported`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketAccelerateConfiguration_migrate_noChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -38,6 +38,8 @@ func resourceBucketNotification() *schema.Resource {
 		UpdateWithoutTimeout: resourceBucketNotificationPut,
 		DeleteWithoutTimeout: resourceBucketNotificationDelete,
 
+		CustomizeDiff: resourceBucketNotificationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:     schema.TypeString,
@@ -403,6 +405,98 @@ func resourceBucketNotificationDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+// resourceBucketNotificationCustomizeDiff rejects lambda_function, queue and topic configurations
+// whose prefix and suffix filters overlap for the same event types, which S3 refuses on PUT.
+// EventBridge delivery is not filtered and so is not considered.
+func resourceBucketNotificationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	var filters []notificationFilter
+
+	for _, k := range []string{"lambda_function", "queue", "topic"} {
+		for i, tfMapRaw := range d.Get(k).([]any) {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			key := fmt.Sprintf("%s.%d", k, i)
+
+			// Values are compared only once they are known.
+			if !d.NewValueKnown(key+".events") || !d.NewValueKnown(key+".filter_prefix") || !d.NewValueKnown(key+".filter_suffix") {
+				continue
+			}
+
+			filter := notificationFilter{
+				key: key,
+			}
+			if v, ok := tfMap["events"].(*schema.Set); ok {
+				filter.events = flex.ExpandStringValueSet(v)
+			}
+			if v, ok := tfMap["filter_prefix"].(string); ok {
+				filter.prefix = v
+			}
+			if v, ok := tfMap["filter_suffix"].(string); ok {
+				filter.suffix = v
+			}
+
+			filters = append(filters, filter)
+		}
+	}
+
+	for i, a := range filters {
+		for _, b := range filters[i+1:] {
+			if a.overlaps(b) {
+				return fmt.Errorf("%s and %s have overlapping filter_prefix and filter_suffix values for the same event types", a.key, b.key)
+			}
+		}
+	}
+
+	return nil
+}
+
+type notificationFilter struct {
+	key    string
+	events []string
+	prefix string
+	suffix string
+}
+
+func (a notificationFilter) overlaps(b notificationFilter) bool {
+	if !strings.HasPrefix(a.prefix, b.prefix) && !strings.HasPrefix(b.prefix, a.prefix) {
+		return false
+	}
+
+	if !strings.HasSuffix(a.suffix, b.suffix) && !strings.HasSuffix(b.suffix, a.suffix) {
+		return false
+	}
+
+	for _, x := range a.events {
+		for _, y := range b.events {
+			if notificationEventsOverlap(x, y) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// notificationEventsOverlap returns whether two event types match a common event, e.g. "s3:ObjectCreated:*" and "s3:ObjectCreated:Put".
+func notificationEventsOverlap(x, y string) bool {
+	if x == y {
+		return true
+	}
+
+	if v, ok := strings.CutSuffix(x, "*"); ok && strings.HasPrefix(y, v) {
+		return true
+	}
+
+	if v, ok := strings.CutSuffix(y, "*"); ok && strings.HasPrefix(x, v) {
+		return true
+	}
+
+	return false
+}
+
 func findBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketNotificationConfigurationOutput, error) {
 	input := &s3.GetBucketNotificationConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -222,6 +222,24 @@ func TestAccS3BucketNotification_Topic_multiple(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketNotification_Topic_overlappingFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketNotificationConfig_topicOverlappingFilters(rName),
+				ExpectError: regexache.MustCompile(`topic.0 and topic.1 have overlapping filter_prefix and filter_suffix values`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketNotification_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v s3.GetBucketNotificationConfigurationOutput
@@ -456,6 +474,46 @@ resource "aws_s3_bucket_notification" "test" {
 `, rName)
 }
 
+func testAccBucketNotificationConfig_topicOverlappingFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_notification" "test" {
+  bucket      = aws_s3_bucket.test.id
+  eventbridge = true
+
+  topic {
+    id        = "notification-sns1"
+    topic_arn = aws_sns_topic.test.arn
+
+    events = [
+      "s3:ObjectCreated:*",
+    ]
+
+    filter_prefix = "tf-acc-test/"
+  }
+
+  topic {
+    id        = "notification-sns2"
+    topic_arn = aws_sns_topic.test.arn
+
+    events = [
+      "s3:ObjectCreated:Put",
+    ]
+
+    filter_prefix = "tf-acc-test/logs/"
+    filter_suffix = ".log"
+  }
+}
+`, rName)
+}
+
 func testAccBucketNotificationConfig_queue(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
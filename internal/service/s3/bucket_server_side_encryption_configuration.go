@@ -15,6 +15,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -99,24 +100,59 @@ func resourceBucketServerSideEncryptionConfiguration() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-			if d.Id() == "" {
-				return nil
-			}
-			if d.HasChange(names.AttrExpectedBucketOwner) {
-				o, n := d.GetChange(names.AttrExpectedBucketOwner)
-				os, ns := o.(string), n.(string)
-				if os == ns {
+		CustomizeDiff: customdiff.All(
+			func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				if d.Id() == "" {
 					return nil
 				}
-				if os == "" && ns == meta.(*conns.AWSClient).AccountID(ctx) {
-					return nil
+				if d.HasChange(names.AttrExpectedBucketOwner) {
+					o, n := d.GetChange(names.AttrExpectedBucketOwner)
+					os, ns := o.(string), n.(string)
+					if os == ns {
+						return nil
+					}
+					if os == "" && ns == meta.(*conns.AWSClient).AccountID(ctx) {
+						return nil
+					}
+					return d.ForceNew(names.AttrExpectedBucketOwner)
 				}
-				return d.ForceNew(names.AttrExpectedBucketOwner)
+				return nil
+			},
+			validateServerSideEncryptionRules,
+		),
+	}
+}
+
+// validateServerSideEncryptionRules checks the interplay of SSE algorithm, KMS key and S3 Bucket Keys.
+func validateServerSideEncryptionRules(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["apply_server_side_encryption_by_default"].([]any)
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMapDefault := v[0].(map[string]any)
+		sseAlgorithm := awstypes.ServerSideEncryption(tfMapDefault["sse_algorithm"].(string))
+
+		switch sseAlgorithm {
+		case awstypes.ServerSideEncryptionAes256:
+			if v, ok := tfMapDefault["kms_master_key_id"].(string); ok && v != "" {
+				return fmt.Errorf("kms_master_key_id can only be set when sse_algorithm is %q or %q", awstypes.ServerSideEncryptionAwsKms, awstypes.ServerSideEncryptionAwsKmsDsse)
 			}
-			return nil
-		},
+		case awstypes.ServerSideEncryptionAwsKmsDsse:
+			// S3 Bucket Keys aren't supported for dual-layer server-side encryption (DSSE-KMS).
+			if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v {
+				return fmt.Errorf("bucket_key_enabled is not supported when sse_algorithm is %q", sseAlgorithm)
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceBucketServerSideEncryptionConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -53,7 +54,29 @@ func resourceEmailIdentityMailFromAttributes() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"mail_from_domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.NewValueKnown("mail_from_domain") {
+					return nil
+				}
+
+				if d.Get("behavior_on_mx_failure").(string) == string(types.BehaviorOnMxFailureRejectMessage) && d.Get("mail_from_domain").(string) == "" {
+					return errMailFromRequired
+				}
+
+				return nil
+			},
+			// Changing the MAIL FROM domain restarts its verification.
+			customdiff.ComputedIf("mail_from_domain_status", func(_ context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange("mail_from_domain")
+			}),
+		),
 	}
 }
 
@@ -116,9 +139,11 @@ func resourceEmailIdentityMailFromAttributesRead(ctx context.Context, d *schema.
 	if out.MailFromAttributes != nil {
 		d.Set("behavior_on_mx_failure", out.MailFromAttributes.BehaviorOnMxFailure)
 		d.Set("mail_from_domain", out.MailFromAttributes.MailFromDomain)
+		d.Set("mail_from_domain_status", out.MailFromAttributes.MailFromDomainStatus)
 	} else {
 		d.Set("behavior_on_mx_failure", nil)
 		d.Set("mail_from_domain", nil)
+		d.Set("mail_from_domain_status", nil)
 	}
 
 	return diags
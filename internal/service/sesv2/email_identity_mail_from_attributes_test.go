@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -151,6 +152,7 @@ func TestAccSESV2EmailIdentityMailFromAttributes_mailFromDomain(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEmailIdentityMailFromAttributesExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain1.String()),
+					resource.TestCheckResourceAttrSet(resourceName, "mail_from_domain_status"),
 				),
 			},
 			{
@@ -163,12 +165,31 @@ func TestAccSESV2EmailIdentityMailFromAttributes_mailFromDomain(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEmailIdentityMailFromAttributesExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain2.String()),
+					resource.TestCheckResourceAttrSet(resourceName, "mail_from_domain_status"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccSESV2EmailIdentityMailFromAttributes_rejectMessageWithoutMailFromDomain(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomDomain().String()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEmailIdentityDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEmailIdentityMailFromAttributesConfig_behaviorOnMXFailureAndMailFromDomain(rName, string(types.BehaviorOnMxFailureRejectMessage), ""),
+				ExpectError: regexache.MustCompile(`mail from domain is required if behavior on MX failure is REJECT_MESSAGE`),
+			},
+		},
+	})
+}
+
 func testAccCheckEmailIdentityMailFromAttributesExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
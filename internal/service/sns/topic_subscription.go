@@ -607,6 +607,11 @@ func normalizeTopicSubscriptionDeliveryPolicy(policy string) ([]byte, error) {
 }
 
 func resourceTopicSubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Get(names.AttrProtocol).(string) == subscriptionProtocolFirehose && diff.GetRawConfig().GetAttr("subscription_role_arn").IsNull() {
+		// Firehose subscriptions are rejected by the API without a role SNS can assume to write to the delivery stream.
+		return errors.New(`subscription_role_arn is required when protocol is "firehose"`)
+	}
+
 	hasPolicy := diff.Get("filter_policy").(string) != ""
 	hasScope := !diff.GetRawConfig().GetAttr("filter_policy_scope").IsNull()
 	hadScope := diff.Get("filter_policy_scope").(string) != ""
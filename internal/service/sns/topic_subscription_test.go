@@ -713,6 +713,24 @@ func TestAccSNSTopicSubscription_firehose(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopicSubscription_firehoseNoSubscriptionRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicSubscriptionConfig_firehoseNoSubscriptionRoleARN(rName),
+				ExpectError: regexache.MustCompile(`subscription_role_arn is required when protocol is "firehose"`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopicSubscription_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
@@ -1513,6 +1531,24 @@ resource "aws_kinesis_firehose_delivery_stream" "test_stream" {
 `, rName)
 }
 
+func testAccTopicSubscriptionConfig_firehoseNoSubscriptionRoleARN(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+data "aws_partition" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  endpoint  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:deliverystream/%[1]s"
+  protocol  = "firehose"
+  topic_arn = aws_sns_topic.test.arn
+}
+`, rName)
+}
+
 // By composing the topic_arn argument value rather than referencing
 // the aws_sns_topic.arn attribute, we can replicate the behavior of
 // an externally managed topic being destroyed and re-created
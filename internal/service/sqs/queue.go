@@ -417,6 +417,20 @@ func resourceQueueCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	deduplicationScope := diff.GetRawConfig().GetAttr("deduplication_scope")
+	fifoThroughputLimit := diff.GetRawConfig().GetAttr("fifo_throughput_limit")
+
+	if !fifoQueue && (!deduplicationScope.IsNull() || !fifoThroughputLimit.IsNull()) {
+		return fmt.Errorf("deduplication_scope and fifo_throughput_limit can only be set for FIFO queue")
+	}
+
+	// High throughput for FIFO queues requires message group deduplication.
+	if fifoThroughputLimit.IsKnown() && !fifoThroughputLimit.IsNull() && fifoThroughputLimit.AsString() == fifoThroughputLimitPerMessageGroupID {
+		if deduplicationScope.IsKnown() && (deduplicationScope.IsNull() || deduplicationScope.AsString() != deduplicationScopeMessageGroup) {
+			return fmt.Errorf(`fifo_throughput_limit = %q requires deduplication_scope = %q`, fifoThroughputLimitPerMessageGroupID, deduplicationScopeMessageGroup)
+		}
+	}
+
 	return nil
 }
 
@@ -666,6 +666,46 @@ func TestAccSQSQueue_FIFOQueue_highThroughputMode(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_FIFOQueue_highThroughputModeExpectDeduplicationScopeError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s.fifo", acctest.RandomWithPrefix(t, acctest.ResourcePrefix))
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_fifoHighThroughputMode(rName, "queue", "perMessageGroupId"),
+				ExpectError: regexache.MustCompile(`fifo_throughput_limit = "perMessageGroupId" requires deduplication_scope = "messageGroup"`),
+			},
+			{
+				Config:      testAccQueueConfig_fifoHighThroughputMode(rName, "null", "perMessageGroupId"),
+				ExpectError: regexache.MustCompile(`fifo_throughput_limit = "perMessageGroupId" requires deduplication_scope = "messageGroup"`),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_StandardQueue_expectHighThroughputModeError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_standardExpectHighThroughputModeError(rName),
+				ExpectError: regexache.MustCompile(`deduplication_scope and fifo_throughput_limit can only be set for FIFO queue`),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_StandardQueue_expectContentBasedDeduplicationError(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -1274,6 +1314,16 @@ resource "aws_sqs_queue" "test" {
 `, rName)
 }
 
+func testAccQueueConfig_standardExpectHighThroughputModeError(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                  = %[1]q
+  deduplication_scope   = "messageGroup"
+  fifo_throughput_limit = "perMessageGroupId"
+}
+`, rName)
+}
+
 func testAccQueueConfig_encryption(rName, kmsDataKeyReusePeriodSeconds string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -0,0 +1,543 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_tax_registration", name="Tax Registration")
+func newTaxRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &taxRegistrationResource{}
+
+	return r, nil
+}
+
+type taxRegistrationResource struct {
+	framework.ResourceWithModel[taxRegistrationResourceModel]
+	framework.WithImportByID
+}
+
+func (r *taxRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tax_registration_entry": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[taxRegistrationEntryModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certified_email_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"legal_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"registration_id": schema.StringAttribute{
+							Required: true,
+						},
+						"registration_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationType](),
+							Required:   true,
+						},
+						"sector": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Sector](),
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"additional_tax_information": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[additionalInfoModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"canada_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[canadaAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"canada_quebec_sales_tax_number": schema.StringAttribute{
+													Optional: true,
+												},
+												"canada_retail_sales_tax_number": schema.StringAttribute{
+													Optional: true,
+												},
+												"is_reseller_account": schema.BoolAttribute{
+													Optional: true,
+												},
+												"provincial_sales_tax_id": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"estonia_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[estoniaAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"registry_commercial_code": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"israel_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[israelAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"customer_type": schema.StringAttribute{
+													Required: true,
+												},
+												"dealer_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"italy_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[italyAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"cig_number": schema.StringAttribute{
+													Optional: true,
+												},
+												"cup_number": schema.StringAttribute{
+													Optional: true,
+												},
+												"sdi_account_id": schema.StringAttribute{
+													Optional: true,
+												},
+												"tax_code": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"kenya_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[kenyaAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"person_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"poland_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[polandAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"individual_registration_number": schema.StringAttribute{
+													Optional: true,
+												},
+												"is_group_vat_enabled": schema.BoolAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"romania_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[romaniaAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"tax_registration_number_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"spain_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[spainAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"registration_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"ukraine_additional_info": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[ukraineAdditionalInfoModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"ukraine_trn_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"legal_address": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"address_line_1": schema.StringAttribute{
+										Required: true,
+									},
+									"address_line_2": schema.StringAttribute{
+										Optional: true,
+									},
+									"address_line_3": schema.StringAttribute{
+										Optional: true,
+									},
+									"city": schema.StringAttribute{
+										Required: true,
+									},
+									"country_code": schema.StringAttribute{
+										Required: true,
+									},
+									"district_or_county": schema.StringAttribute{
+										Optional: true,
+									},
+									"postal_code": schema.StringAttribute{
+										Required: true,
+									},
+									"state_or_region": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *taxRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data taxRegistrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	accountID := data.AccountID.ValueString()
+	var input taxsettings.PutTaxRegistrationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PutTaxRegistration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *taxRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data taxRegistrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	output, err := findTaxRegistrationByID(ctx, conn, data.AccountID.ValueString())
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Tax Settings Tax Registration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The API returns the registration itself rather than the entry that was put.
+	var entry taxRegistrationEntryModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &entry)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.TaxRegistrationEntry = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &entry)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *taxRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old taxRegistrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	if !new.TaxRegistrationEntry.Equal(old.TaxRegistrationEntry) {
+		var input taxsettings.PutTaxRegistrationInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.PutTaxRegistration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Tax Settings Tax Registration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.Status)
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *taxRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data taxRegistrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	input := taxsettings.DeleteTaxRegistrationInput{
+		AccountId: fwflex.StringFromFramework(ctx, data.AccountID),
+	}
+	_, err := conn.DeleteTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Tax Settings Tax Registration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findTaxRegistrationByID(ctx context.Context, conn *taxsettings.Client, accountID string) (*awstypes.TaxRegistration, error) {
+	input := taxsettings.GetTaxRegistrationInput{
+		AccountId: aws.String(accountID),
+	}
+	output, err := conn.GetTaxRegistration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TaxRegistration == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	if status := output.TaxRegistration.Status; status == awstypes.TaxRegistrationStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output.TaxRegistration, nil
+}
+
+type taxRegistrationResourceModel struct {
+	framework.WithRegionModel
+	AccountID            types.String                                               `tfsdk:"account_id"`
+	ID                   types.String                                               `tfsdk:"id" autoflex:"-"`
+	Status               fwtypes.StringEnum[awstypes.TaxRegistrationStatus]         `tfsdk:"status" autoflex:"-"`
+	TaxRegistrationEntry fwtypes.ListNestedObjectValueOf[taxRegistrationEntryModel] `tfsdk:"tax_registration_entry"`
+}
+
+func (m *taxRegistrationResourceModel) InitFromID() error {
+	m.AccountID = m.ID
+
+	return nil
+}
+
+func (m *taxRegistrationResourceModel) setID() {
+	m.ID = m.AccountID
+}
+
+type taxRegistrationEntryModel struct {
+	AdditionalTaxInformation fwtypes.ListNestedObjectValueOf[additionalInfoModel] `tfsdk:"additional_tax_information"`
+	CertifiedEmailID         types.String                                         `tfsdk:"certified_email_id"`
+	LegalAddress             fwtypes.ListNestedObjectValueOf[addressModel]        `tfsdk:"legal_address"`
+	LegalName                types.String                                         `tfsdk:"legal_name"`
+	RegistrationID           types.String                                         `tfsdk:"registration_id"`
+	RegistrationType         fwtypes.StringEnum[awstypes.TaxRegistrationType]     `tfsdk:"registration_type"`
+	Sector                   fwtypes.StringEnum[awstypes.Sector]                  `tfsdk:"sector"`
+}
+
+type additionalInfoModel struct {
+	CanadaAdditionalInfo  fwtypes.ListNestedObjectValueOf[canadaAdditionalInfoModel]  `tfsdk:"canada_additional_info"`
+	EstoniaAdditionalInfo fwtypes.ListNestedObjectValueOf[estoniaAdditionalInfoModel] `tfsdk:"estonia_additional_info"`
+	IsraelAdditionalInfo  fwtypes.ListNestedObjectValueOf[israelAdditionalInfoModel]  `tfsdk:"israel_additional_info"`
+	ItalyAdditionalInfo   fwtypes.ListNestedObjectValueOf[italyAdditionalInfoModel]   `tfsdk:"italy_additional_info"`
+	KenyaAdditionalInfo   fwtypes.ListNestedObjectValueOf[kenyaAdditionalInfoModel]   `tfsdk:"kenya_additional_info"`
+	PolandAdditionalInfo  fwtypes.ListNestedObjectValueOf[polandAdditionalInfoModel]  `tfsdk:"poland_additional_info"`
+	RomaniaAdditionalInfo fwtypes.ListNestedObjectValueOf[romaniaAdditionalInfoModel] `tfsdk:"romania_additional_info"`
+	SpainAdditionalInfo   fwtypes.ListNestedObjectValueOf[spainAdditionalInfoModel]   `tfsdk:"spain_additional_info"`
+	UkraineAdditionalInfo fwtypes.ListNestedObjectValueOf[ukraineAdditionalInfoModel] `tfsdk:"ukraine_additional_info"`
+}
+
+type canadaAdditionalInfoModel struct {
+	CanadaQuebecSalesTaxNumber types.String `tfsdk:"canada_quebec_sales_tax_number"`
+	CanadaRetailSalesTaxNumber types.String `tfsdk:"canada_retail_sales_tax_number"`
+	IsResellerAccount          types.Bool   `tfsdk:"is_reseller_account"`
+	ProvincialSalesTaxID       types.String `tfsdk:"provincial_sales_tax_id"`
+}
+
+type estoniaAdditionalInfoModel struct {
+	RegistryCommercialCode types.String `tfsdk:"registry_commercial_code"`
+}
+
+type israelAdditionalInfoModel struct {
+	CustomerType types.String `tfsdk:"customer_type"`
+	DealerType   types.String `tfsdk:"dealer_type"`
+}
+
+type italyAdditionalInfoModel struct {
+	CigNumber    types.String `tfsdk:"cig_number"`
+	CupNumber    types.String `tfsdk:"cup_number"`
+	SdiAccountID types.String `tfsdk:"sdi_account_id"`
+	TaxCode      types.String `tfsdk:"tax_code"`
+}
+
+type kenyaAdditionalInfoModel struct {
+	PersonType types.String `tfsdk:"person_type"`
+}
+
+type polandAdditionalInfoModel struct {
+	IndividualRegistrationNumber types.String `tfsdk:"individual_registration_number"`
+	IsGroupVatEnabled            types.Bool   `tfsdk:"is_group_vat_enabled"`
+}
+
+type romaniaAdditionalInfoModel struct {
+	TaxRegistrationNumberType types.String `tfsdk:"tax_registration_number_type"`
+}
+
+type spainAdditionalInfoModel struct {
+	RegistrationType types.String `tfsdk:"registration_type"`
+}
+
+type ukraineAdditionalInfoModel struct {
+	UkraineTrnType types.String `tfsdk:"ukraine_trn_type"`
+}
+
+type addressModel struct {
+	AddressLine1     types.String `tfsdk:"address_line_1"`
+	AddressLine2     types.String `tfsdk:"address_line_2"`
+	AddressLine3     types.String `tfsdk:"address_line_3"`
+	City             types.String `tfsdk:"city"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	DistrictOrCounty types.String `tfsdk:"district_or_county"`
+	PostalCode       types.String `tfsdk:"postal_code"`
+	StateOrRegion    types.String `tfsdk:"state_or_region"`
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Tax registrations are account-wide and are validated by AWS, so a real
+// registration ID must be supplied for these tests to run.
+func TestAccTaxSettingsTaxRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_REGISTRATION_ID")
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, t, resourceName),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, "tax_registration_entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tax_registration_entry.0.registration_id", registrationID),
+					resource.TestCheckResourceAttr(resourceName, "tax_registration_entry.0.registration_type", "VAT"),
+					resource.TestCheckResourceAttr(resourceName, "tax_registration_entry.0.legal_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tax_registration_entry.0.legal_address.0.country_code", "EE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTaxSettingsTaxRegistration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, "TAXSETTINGS_REGISTRATION_ID")
+	resourceName := "aws_taxsettings_tax_registration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, t, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tftaxsettings.ResourceTaxRegistration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTaxRegistrationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_tax_registration" {
+				continue
+			}
+
+			_, err := tftaxsettings.FindTaxRegistrationByID(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Tax Settings Tax Registration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTaxRegistrationExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).TaxSettingsClient(ctx)
+
+		_, err := tftaxsettings.FindTaxRegistrationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTaxRegistrationConfig_basic(registrationID string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_tax_registration" "test" {
+  tax_registration_entry {
+    registration_id   = %[1]q
+    registration_type = "VAT"
+    legal_name        = "Example Company"
+
+    legal_address {
+      address_line_1 = "Narva mnt 7"
+      city           = "Tallinn"
+      country_code   = "EE"
+      postal_code    = "10117"
+    }
+  }
+}
+`, registrationID)
+}
@@ -87,6 +87,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"ModifyWithOptions":     testAccUser_modifyWithOptions,
 			"Posix":                 testAccUser_posix,
 			"UserNameValidation":    testAccUser_UserName_Validation,
+			"Validation":            testAccUser_validation,
 		},
 	}
 
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
@@ -65,14 +66,20 @@ func resourceUser() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"entry": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringMatch(regexache.MustCompile(`^/`), "must be an absolute path"),
+							),
 						},
 						names.AttrTarget: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringMatch(regexache.MustCompile(`^/`), "must be an absolute path"),
+							),
 						},
 					},
 				},
@@ -91,17 +98,22 @@ func resourceUser() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"gid": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validPOSIXID,
 						},
 						"secondary_gids": {
-							Type:     schema.TypeSet,
-							Elem:     &schema.Schema{Type: schema.TypeInt},
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validPOSIXID,
+							},
 							Optional: true,
 						},
 						"uid": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validPOSIXID,
 						},
 					},
 				},
@@ -244,12 +256,20 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.HomeDirectory = aws.String(d.Get("home_directory").(string))
 		}
 
-		if d.HasChange("home_directory_mappings") {
+		homeDirectoryType := awstypes.HomeDirectoryType(d.Get("home_directory_type").(string))
+
+		// Mappings must be resent when switching to LOGICAL, even if they haven't changed.
+		if d.HasChange("home_directory_mappings") || (d.HasChange("home_directory_type") && homeDirectoryType == awstypes.HomeDirectoryTypeLogical) {
 			input.HomeDirectoryMappings = expandHomeDirectoryMapEntries(d.Get("home_directory_mappings").([]any))
+
+			// An explicit empty list is required to remove all mappings.
+			if input.HomeDirectoryMappings == nil {
+				input.HomeDirectoryMappings = []awstypes.HomeDirectoryMapEntry{}
+			}
 		}
 
 		if d.HasChange("home_directory_type") {
-			input.HomeDirectoryType = awstypes.HomeDirectoryType(d.Get("home_directory_type").(string))
+			input.HomeDirectoryType = homeDirectoryType
 		}
 
 		if d.HasChange(names.AttrPolicy) {
@@ -292,6 +292,24 @@ func testAccUser_homeDirectoryMappings(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccUserConfig_homeDirectoryTypePath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_type", "PATH"),
+				),
+			},
+			{
+				Config: testAccUserConfig_homeDirectoryMappings(rName, entry1, target1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, t, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.0.entry", entry1),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.0.target", target1),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_type", "LOGICAL"),
+				),
+			},
 			{
 				Config: testAccUserConfig_homeDirectoryMappingsRemove(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -304,6 +322,36 @@ func testAccUser_homeDirectoryMappings(t *testing.T) {
 	})
 }
 
+func testAccUser_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "relative/entry", "/bucket/target"),
+				ExpectError: regexache.MustCompile(`must be an absolute path`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "/entry", "bucket/target"),
+				ExpectError: regexache.MustCompile(`must be an absolute path`),
+			},
+			{
+				Config:      testAccUserConfig_posixIDs(rName, -1, 1000),
+				ExpectError: regexache.MustCompile(`"posix_profile.0.gid" must be between 0 and 4294967295`),
+			},
+			{
+				Config:      testAccUserConfig_posixIDs(rName, 1000, 4294967296),
+				ExpectError: regexache.MustCompile(`"posix_profile.0.uid" must be between 0 and 4294967295`),
+			},
+		},
+	})
+}
+
 func testAccCheckUserExists(ctx context.Context, t *testing.T, n string, v *awstypes.DescribedUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -690,6 +738,21 @@ resource "aws_transfer_user" "test" {
 `, rName))
 }
 
+func testAccUserConfig_homeDirectoryTypePath(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_user" "test" {
+  home_directory_type = "PATH"
+  role                = aws_iam_role.test.arn
+  server_id           = aws_transfer_server.test.id
+  user_name           = "tftestuser"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccUserConfig_posix(rName string) string {
 	return acctest.ConfigCompose(testAccUserConfig_baseRole(rName), fmt.Sprintf(`
 resource "aws_transfer_server" "test" {
@@ -748,3 +811,26 @@ resource "aws_transfer_user" "test" {
 }
 `, rName))
 }
+
+func testAccUserConfig_posixIDs(rName string, gid, uid int64) string {
+	return acctest.ConfigCompose(testAccUserConfig_baseRole(rName), fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  domain = "EFS"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_transfer_user" "test" {
+  server_id = aws_transfer_server.test.id
+  user_name = "tftestuser"
+  role      = aws_iam_role.test.arn
+
+  posix_profile {
+    gid = %[2]d
+    uid = %[3]d
+  }
+}
+`, rName, gid, uid))
+}
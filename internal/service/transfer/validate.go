@@ -5,6 +5,7 @@ package transfer
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/YakDriver/regexache"
 )
@@ -31,3 +32,12 @@ func validUserName(v any, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validPOSIXID(v any, k string) (ws []string, errors []error) {
+	value := int64(v.(int))
+	// https://docs.aws.amazon.com/transfer/latest/userguide/API_PosixProfile.html
+	if value < 0 || value > math.MaxUint32 {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and %d, got: %d", k, uint32(math.MaxUint32), value))
+	}
+	return
+}